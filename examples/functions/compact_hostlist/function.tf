@@ -0,0 +1,4 @@
+output "gpu_nodes" {
+  # e.g. "gpu[01-16,20]"
+  value = provider::pbs::compact_hostlist([for n in pbs_node.gpu : n.name])
+}
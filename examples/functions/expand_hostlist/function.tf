@@ -0,0 +1,16 @@
+# One pbs_node per GPU host
+resource "pbs_node" "gpu" {
+  for_each = toset(provider::pbs::expand_hostlist("gpu[01-16,20]"))
+
+  name        = each.value
+  resv_enable = true
+}
+
+# Allow jobs to be submitted from the login nodes
+resource "pbs_queue_acl_entry" "login" {
+  for_each = toset(provider::pbs::expand_hostlist("login[01-04]"))
+
+  queue = "workq"
+  acl   = "acl_hosts"
+  entry = each.value
+}
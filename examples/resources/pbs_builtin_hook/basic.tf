@@ -0,0 +1,12 @@
+# Enable and tune a built-in PBS hook
+resource "pbs_builtin_hook" "power" {
+  name    = "PBS_power"
+  enabled = true
+
+  # Optional settings
+  freq  = 300
+  alarm = 180
+}
+
+# Import existing built-in hook:
+# terraform import pbs_builtin_hook.power PBS_power
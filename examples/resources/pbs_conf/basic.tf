@@ -0,0 +1,9 @@
+# Route an execution host's MoM through the comm daemons on the leaf routers
+resource "pbs_conf" "node01" {
+  host = "node01"
+
+  settings = {
+    PBS_START_MOM    = "1"
+    PBS_LEAF_ROUTERS = "comm1,comm2"
+  }
+}
@@ -0,0 +1,32 @@
+# Reload the scheduler whenever its configuration changes
+resource "pbs_sched_config" "this" {
+  strict_ordering = true
+}
+
+resource "pbs_daemon_action" "sched" {
+  daemon = "pbs_sched"
+  action = "hup"
+
+  triggers = {
+    sched_config = jsonencode(pbs_sched_config.this)
+  }
+}
+
+# Restart the MoM on node01 when pbs.conf changes
+resource "pbs_conf" "node01" {
+  host = "node01"
+
+  settings = {
+    PBS_LEAF_ROUTERS = "comm1,comm2"
+  }
+}
+
+resource "pbs_daemon_action" "node01_mom" {
+  daemon = "pbs_mom"
+  action = "restart"
+  host   = pbs_conf.node01.host
+
+  triggers = {
+    settings = jsonencode(pbs_conf.node01.settings)
+  }
+}
@@ -0,0 +1,7 @@
+# Keep the cluster for the benchmark team while the new racks are accepted
+resource "pbs_dedicated_time" "this" {
+  windows = [
+    { start = "2026-11-02T08:00", end = "2026-11-02T17:00" },
+    { start = "2026-11-03T08:00", end = "2026-11-03T17:00" },
+  ]
+}
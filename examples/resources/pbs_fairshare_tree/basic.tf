@@ -0,0 +1,10 @@
+# Split the cluster 60/40 between two departments
+resource "pbs_fairshare_tree" "this" {
+  entities = [
+    { name = "physics", id = 100, parent = "root", shares = 60 },
+    { name = "chemistry", id = 200, parent = "root", shares = 40 },
+    { name = "theory", id = 101, parent = "physics", shares = 20 },
+    { name = "alice", id = 1001, parent = "theory", shares = 1 },
+    { name = "bob", id = 2001, parent = "chemistry", shares = 1 },
+  ]
+}
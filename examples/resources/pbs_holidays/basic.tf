@@ -0,0 +1,15 @@
+# Prime time is 6am to 5:30pm on weekdays, weekends and holidays are non-prime
+resource "pbs_holidays" "this" {
+  year = 2026
+
+  prime_time = [
+    { day = "weekday", prime_start = "0600", non_prime_start = "1730" },
+    { day = "saturday", prime_start = "none", non_prime_start = "all" },
+    { day = "sunday", prime_start = "none", non_prime_start = "all" },
+  ]
+
+  holidays = [
+    { date = "2026-01-01", name = "New Year's Day" },
+    { date = "2026-12-25", name = "Christmas Day" },
+  ]
+}
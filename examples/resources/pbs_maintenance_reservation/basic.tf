@@ -0,0 +1,7 @@
+# Take the first rack out of service for a firmware update
+resource "pbs_maintenance_reservation" "firmware" {
+  name     = "firmware-rack1"
+  hosts    = ["cn[001-032]"]
+  start    = "2026-11-04T06:00:00Z"
+  duration = "04:00:00"
+}
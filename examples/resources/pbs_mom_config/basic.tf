@@ -0,0 +1,17 @@
+# Let the server connect, copy /home locally and kill stray processes
+resource "pbs_mom_config" "this" {
+  node          = "node01"
+  clienthost    = ["pbs"]
+  usecp         = ["*:/home /home"]
+  restrict_user = true
+
+  restrict_user_exceptions = ["root"]
+
+  connection = {
+    username = "root"
+  }
+
+  options = {
+    logevent = "0x1ff"
+  }
+}
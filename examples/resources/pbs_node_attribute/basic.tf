@@ -0,0 +1,16 @@
+# Set by the scheduling stack on a node created by the provisioning stack
+resource "pbs_node_attribute" "ngpus" {
+  node     = "node01"
+  name     = "resources_available"
+  resource = "ngpus"
+  value    = "4"
+}
+
+resource "pbs_node_attribute" "priority" {
+  node  = "node01"
+  name  = "priority"
+  value = "10"
+}
+
+# Import existing attribute:
+# terraform import pbs_node_attribute.ngpus node01/resources_available.ngpus
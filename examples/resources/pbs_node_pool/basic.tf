@@ -0,0 +1,26 @@
+# 512 CPU nodes and four GPU nodes managed from two hostlist patterns
+resource "pbs_node_pool" "cpu" {
+  name      = "cpu"
+  hosts     = ["cn[001-512]"]
+  partition = "cpu"
+  priority  = 10
+}
+
+resource "pbs_node_pool" "gpu" {
+  name      = "gpu"
+  hosts     = ["gpu[01-04]"]
+  partition = "gpu"
+
+  resources_available = {
+    ngpus = "4"
+  }
+
+  # gpu04 has twice as many cards as the rest of the pool
+  overrides = {
+    gpu04 = {
+      resources_available = {
+        ngpus = "8"
+      }
+    }
+  }
+}
@@ -0,0 +1,16 @@
+# Grant a project team access to a queue owned by another configuration
+resource "pbs_queue_acl_entry" "alice" {
+  queue = "gpu"
+  acl   = "acl_users"
+  entry = "+alice@*"
+}
+
+# Deny a host without touching the rest of the ACL
+resource "pbs_queue_acl_entry" "untrusted" {
+  queue = "gpu"
+  acl   = "acl_hosts"
+  entry = "-login99.example.com"
+}
+
+# Import existing ACL entry:
+# terraform import pbs_queue_acl_entry.alice 'gpu/acl_users/+alice@*'
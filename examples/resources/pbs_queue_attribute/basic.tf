@@ -0,0 +1,16 @@
+# Set by the scheduling stack on a queue created by another configuration
+resource "pbs_queue_attribute" "priority" {
+  queue = "gpu"
+  name  = "priority"
+  value = "100"
+}
+
+resource "pbs_queue_attribute" "max_gpus" {
+  queue    = "gpu"
+  name     = "resources_max"
+  resource = "ngpus"
+  value    = "8"
+}
+
+# Import existing attribute:
+# terraform import pbs_queue_attribute.max_gpus gpu/resources_max.ngpus
@@ -0,0 +1,22 @@
+# A one off reservation for a training event
+resource "pbs_reservation" "training" {
+  name   = "hpc-training"
+  start  = "2026-11-02T09:00:00Z"
+  end    = "2026-11-02T17:00:00Z"
+  select = "4:ncpus=8:mem=32gb"
+  users  = ["trainer", "student01", "student02"]
+}
+
+# Two nodes every Monday morning for the next four weeks
+resource "pbs_reservation" "weekly_tests" {
+  name       = "weekly-tests"
+  start      = "2026-11-02T06:00:00Z"
+  duration   = "02:00:00"
+  select     = "2:ncpus=4"
+  recurrence = "FREQ=WEEKLY;BYDAY=MO;COUNT=4"
+  timezone   = "Europe/London"
+}
+
+output "training_queue" {
+  value = pbs_reservation.training.queue
+}
@@ -7,5 +7,13 @@ resource "pbs_resource" "this" {
   flag = "q"
 }
 
+# A host level resource the scheduler checks when placing jobs
+resource "pbs_resource" "ngpus" {
+  name        = "ngpus"
+  type        = "long"
+  flag        = "nh"
+  schedulable = true
+}
+
 # Import existing resource:
 # terraform import pbs_resource.this myres
@@ -0,0 +1,10 @@
+# Schedule on GPUs and run jobs in strict priority order
+resource "pbs_sched_config" "this" {
+  resources       = ["ncpus", "mem", "arch", "host", "vnode", "aoe", "eoe", "ngpus"]
+  strict_ordering = true
+
+  options = {
+    smp_cluster_dist   = "pack ALL"
+    help_starving_jobs = "true ALL"
+  }
+}
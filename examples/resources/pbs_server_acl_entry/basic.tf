@@ -0,0 +1,8 @@
+# Allow a single user to submit reservations
+resource "pbs_server_acl_entry" "alice" {
+  acl   = "acl_resv_users"
+  entry = "+alice@*"
+}
+
+# Import existing ACL entry:
+# terraform import pbs_server_acl_entry.alice 'acl_resv_users/+alice@*'
@@ -0,0 +1,15 @@
+# Owned by the platform team
+resource "pbs_server_attribute" "scheduler_iteration" {
+  name  = "scheduler_iteration"
+  value = "600"
+}
+
+# Owned by the research team, a single resource sub-key of resources_available
+resource "pbs_server_attribute" "licenses" {
+  name     = "resources_available"
+  resource = "matlab_licenses"
+  value    = "20"
+}
+
+# Import existing attribute:
+# terraform import pbs_server_attribute.licenses resources_available.matlab_licenses
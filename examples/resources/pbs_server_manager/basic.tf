@@ -0,0 +1,13 @@
+# Grant a single user PBS manager privileges without owning the whole managers list
+resource "pbs_server_manager" "alice" {
+  user = "alice@*"
+}
+
+# One resource per entry lets separate stacks manage their own managers
+resource "pbs_server_manager" "ops" {
+  for_each = toset(["bob@login01.example.com", "carol@*"])
+  user     = each.value
+}
+
+# Import existing manager entry:
+# terraform import pbs_server_manager.alice 'alice@*'
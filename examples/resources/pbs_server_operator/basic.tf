@@ -0,0 +1,13 @@
+# Grant a single user PBS operator privileges without owning the whole operators list
+resource "pbs_server_operator" "alice" {
+  user = "alice@*"
+}
+
+# One resource per entry lets separate stacks manage their own operators
+resource "pbs_server_operator" "ops" {
+  for_each = toset(["bob@login01.example.com", "carol@*"])
+  user     = each.value
+}
+
+# Import existing operator entry:
+# terraform import pbs_server_operator.alice 'alice@*'
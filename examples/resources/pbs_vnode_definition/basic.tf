@@ -0,0 +1,28 @@
+# Split a two socket GPU host into one vnode per socket
+resource "pbs_vnode_definition" "this" {
+  node = "gpu01"
+  name = "sockets"
+
+  vnodes = [
+    {
+      name    = "gpu01"
+      sharing = "force_excl"
+    },
+    {
+      name = "gpu01[0]"
+      resources_available = {
+        ncpus = "32"
+        mem   = "256gb"
+        ngpus = "2"
+      }
+    },
+    {
+      name = "gpu01[1]"
+      resources_available = {
+        ncpus = "32"
+        mem   = "256gb"
+        ngpus = "2"
+      }
+    },
+  ]
+}
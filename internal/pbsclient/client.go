@@ -3,9 +3,11 @@ package pbsclient
 import (
 	"fmt"
 	"io"
+	"net"
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 
 	"golang.org/x/crypto/ssh"
 )
@@ -33,9 +35,39 @@ func escapeStringForQmgr(value string) string {
 	}
 }
 
+// escapeStringForShell wraps a value in single quotes so that it is passed to a command as a single argument
+// without any shell expansion.
+func escapeStringForShell(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
 type PbsClient struct {
 	SshClientConfig *ssh.ClientConfig
 	Address         string
+
+	// schedConfigMutex serialises edits to sched_config, several resources can change it in the same apply
+	schedConfigMutex sync.Mutex
+}
+
+// ForHost returns a client which runs commands on another host in the complex, such as the MoM on an execution
+// host. host, port and sshConfig default to the ones used for the server when they aren't given.
+func (c *PbsClient) ForHost(host string, port string, sshConfig *ssh.ClientConfig) *PbsClient {
+	if serverHost, serverPort, err := net.SplitHostPort(c.Address); err == nil {
+		if host == "" {
+			host = serverHost
+		}
+		if port == "" {
+			port = serverPort
+		}
+	}
+	if sshConfig == nil {
+		sshConfig = c.SshClientConfig
+	}
+
+	return &PbsClient{
+		SshClientConfig: sshConfig,
+		Address:         net.JoinHostPort(host, port),
+	}
 }
 
 func runSshCommand(sshClient *ssh.Client, cmd string) ([]byte, []byte, error) {
@@ -179,6 +211,42 @@ func generateUpdateStringAttributeCommand(obj string, name string, attribute str
 	return []string{}
 }
 
+// generateListEntryCommand adds (operator "+=") or removes (operator "-=") a single entry from a list valued
+// attribute without touching any other entries in the list.
+func generateListEntryCommand(obj string, name string, attribute string, operator string, entry string) string {
+	return fmt.Sprintf("/opt/pbs/bin/qmgr -c 'set %s %s %s%s%s'", obj, name, attribute, operator, escapeStringForQmgr(entry))
+}
+
+// splitListAttribute splits a comma separated list attribute as reported by qmgr into its entries.
+func splitListAttribute(value *string) []string {
+	entries := []string{}
+	if value == nil {
+		return entries
+	}
+
+	for _, entry := range strings.Split(*value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+// FindListEntry looks for entry in the entries of a list attribute and returns the entry exactly as PBS
+// stores it. ACL entries may be written with or without a leading "+" (grant is the default) so the two
+// forms are treated as equal.
+func FindListEntry(entries []string, entry string) (string, bool) {
+	for _, e := range entries {
+		if strings.TrimPrefix(e, "+") == strings.TrimPrefix(entry, "+") {
+			return e, true
+		}
+	}
+
+	return "", false
+}
+
 var (
 	nameRegex              = regexp.MustCompile(`^(\w+)\s+([a-zA-Z\-_0-9]+)$`)
 	attributeRegex         = regexp.MustCompile(`^    (\w+)\s+=\s+(.+)$`)
@@ -362,3 +430,101 @@ func generateUpdateAttributeCommand(oldAttr any, newAttr any, qmgrObjectType str
 		return nil, fmt.Errorf("unsupported type %T", old)
 	}
 }
+
+// qmgrAttributeName returns the name qmgr uses for an attribute, e.g. resources_available.ncpus when a
+// resource sub-key is given.
+func qmgrAttributeName(attribute string, resource *string) string {
+	if resource == nil || *resource == "" {
+		return attribute
+	}
+
+	return attribute + "." + *resource
+}
+
+// getQmgrAttributeValue returns the raw value of a single attribute (or resource sub-key of an attribute)
+// from parsed qmgr output, or nil if the attribute is not set.
+func getQmgrAttributeValue(result qmgrResult, attribute string, resource *string) *string {
+	value, ok := result.attributes[attribute]
+	if !ok {
+		return nil
+	}
+
+	if resource == nil || *resource == "" {
+		if s, ok := value.(string); ok {
+			return &s
+		}
+		return nil
+	}
+
+	if m, ok := value.(map[string]string); ok {
+		if s, ok := m[*resource]; ok {
+			return &s
+		}
+	}
+
+	return nil
+}
+
+// getQmgrObject returns the raw qmgr attributes of a single named object such as a queue or node. The returned
+// bool is false if the object doesn't exist. An empty name matches the first object, which is how the server is
+// looked up as there is only ever one.
+func (client *PbsClient) getQmgrObject(objType string, name string) (qmgrResult, bool, error) {
+	out, errOutput, err := client.runCommand(fmt.Sprintf("/opt/pbs/bin/qmgr -c 'list %s @default'", objType))
+	if err != nil {
+		return qmgrResult{}, false, fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	for _, r := range parseGenericQmgrOutput(string(out)) {
+		if name == "" || r.name == name {
+			return r, true, nil
+		}
+	}
+
+	return qmgrResult{}, false, nil
+}
+
+// getQmgrObjectAttribute returns the value of a single attribute on a named object, or nil if the attribute is
+// not set. The returned bool is false if the object doesn't exist.
+func (client *PbsClient) getQmgrObjectAttribute(objType string, name string, attribute string, resource *string) (*string, bool, error) {
+	obj, found, err := client.getQmgrObject(objType, name)
+	if err != nil || !found {
+		return nil, found, err
+	}
+
+	return getQmgrAttributeValue(obj, attribute, resource), true, nil
+}
+
+// updateQmgrObjectAttribute sets a single attribute on a named object without touching any other attribute.
+// Passing a nil value unsets the attribute, which is a no-op if the object no longer exists.
+func (client *PbsClient) updateQmgrObjectAttribute(objType string, name string, attribute string, resource *string, value *string) error {
+	obj, found, err := client.getQmgrObject(objType, name)
+	if err != nil {
+		return err
+	}
+	if !found {
+		if value == nil {
+			return nil
+		}
+		return fmt.Errorf("%s %s does not exist", objType, name)
+	}
+
+	oldValue := getQmgrAttributeValue(obj, attribute, resource)
+	commands, err := generateUpdateAttributeCommand(oldValue, value, objType, obj.name, qmgrAttributeName(attribute, resource))
+	if err != nil {
+		return err
+	}
+	if len(commands) == 0 {
+		return nil
+	}
+
+	_, errOutput, err := client.runCommands(commands)
+	if err != nil {
+		completeErrOutput := ""
+		for _, e := range errOutput {
+			completeErrOutput += string(e)
+		}
+		return fmt.Errorf("%s %s %s", err, completeErrOutput, strings.Join(commands, ","))
+	}
+
+	return nil
+}
@@ -110,3 +110,20 @@ func TestGenerateUpdateStringAttributeCommand(t *testing.T) {
 func stringPtr(s string) *string {
 	return &s
 }
+
+func TestEscapeStringForShell(t *testing.T) {
+	testCases := map[string]string{
+		"simple":          `'simple'`,
+		"with spaces":     `'with spaces'`,
+		"$(rm -rf /)":     `'$(rm -rf /)'`,
+		"it's":            `'it'\''s'`,
+		`"double" quotes`: `'"double" quotes'`,
+		"":                `''`,
+	}
+
+	for input, expected := range testCases {
+		if got := escapeStringForShell(input); got != expected {
+			t.Errorf("got %q, wanted %q", got, expected)
+		}
+	}
+}
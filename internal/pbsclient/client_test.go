@@ -359,3 +359,123 @@ func TestParseQmgrOutputWithTabContinuationLines(t *testing.T) {
 		t.Errorf("got %q, wanted %q", parsedOutput[0].attributes["log_events"], "511")
 	}
 }
+
+func TestGenerateListEntryCommand(t *testing.T) {
+	add := generateListEntryCommand("server", "pbs", "managers", "+=", "alice@*")
+	if add != "/opt/pbs/bin/qmgr -c 'set server pbs managers+=\"alice@*\"'" {
+		t.Errorf("got %q", add)
+	}
+
+	remove := generateListEntryCommand("server", "pbs", "operators", "-=", "bob@host.example.com")
+	if remove != "/opt/pbs/bin/qmgr -c 'set server pbs operators-=\"bob@host.example.com\"'" {
+		t.Errorf("got %q", remove)
+	}
+}
+
+func TestSplitListAttribute(t *testing.T) {
+	value := "alice@*, bob@*,,root@host.example.com"
+	entries := splitListAttribute(&value)
+
+	expected := []string{"alice@*", "bob@*", "root@host.example.com"}
+	if len(entries) != len(expected) {
+		t.Errorf("expected %d entries but got %d (%v)", len(expected), len(entries), entries)
+		return
+	}
+	for i, entry := range entries {
+		if entry != expected[i] {
+			t.Errorf("got %q, wanted %q", entry, expected[i])
+		}
+	}
+
+	if len(splitListAttribute(nil)) != 0 {
+		t.Errorf("expected no entries for a nil attribute")
+	}
+	if _, ok := FindListEntry(splitListAttribute(&value), "bob@*"); !ok {
+		t.Errorf("expected bob@* to be in the list")
+	}
+	if _, ok := FindListEntry(splitListAttribute(&value), "bob"); ok {
+		t.Errorf("expected bob not to match bob@*")
+	}
+}
+
+func TestFindListEntryAclSigns(t *testing.T) {
+	value := "+alice@*,-bob@*,carol@*"
+
+	if stored, ok := FindListEntry(splitListAttribute(&value), "alice@*"); !ok || stored != "+alice@*" {
+		t.Errorf("got %q, wanted %q", stored, "+alice@*")
+	}
+	if stored, ok := FindListEntry(splitListAttribute(&value), "+carol@*"); !ok || stored != "carol@*" {
+		t.Errorf("got %q, wanted %q", stored, "carol@*")
+	}
+	if stored, ok := FindListEntry(splitListAttribute(&value), "-bob@*"); !ok || stored != "-bob@*" {
+		t.Errorf("got %q, wanted %q", stored, "-bob@*")
+	}
+	if _, ok := FindListEntry(splitListAttribute(&value), "bob@*"); ok {
+		t.Errorf("expected a grant for bob@* not to match the deny entry")
+	}
+}
+
+func TestGetQmgrAttributeValue(t *testing.T) {
+	parsed := parseGenericQmgrOutput(`Server pbs
+    scheduling = True
+    resources_available.ncpus = 8
+    resources_default.walltime = 01:00:00
+    default_queue = workq`)
+	if len(parsed) != 1 {
+		t.Fatalf("expected 1 output from parsing result but got %d", len(parsed))
+	}
+
+	ncpus := "ncpus"
+	mem := "mem"
+	tests := []struct {
+		attribute string
+		resource  *string
+		want      *string
+	}{
+		{"scheduling", nil, stringPtr("True")},
+		{"default_queue", nil, stringPtr("workq")},
+		{"resources_available", &ncpus, stringPtr("8")},
+		{"resources_available", &mem, nil},
+		{"resources_max", &ncpus, nil},
+		{"query_other_jobs", nil, nil},
+		// A resource attribute can't be read as a plain string
+		{"resources_available", nil, nil},
+	}
+
+	for _, tt := range tests {
+		got := getQmgrAttributeValue(parsed[0], tt.attribute, tt.resource)
+		if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+			t.Errorf("%s: got %v, wanted %v", qmgrAttributeName(tt.attribute, tt.resource), got, tt.want)
+		}
+	}
+}
+
+func TestGenerateSingleAttributeCommands(t *testing.T) {
+	ncpus := "ncpus"
+	oldValue := "4"
+	newValue := "8"
+
+	commands, err := generateUpdateAttributeCommand((*string)(nil), &newValue, "server", "pbs", qmgrAttributeName("resources_available", &ncpus))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(commands) != 1 || commands[0] != `/opt/pbs/bin/qmgr -c 'set server pbs resources_available.ncpus="8"'` {
+		t.Errorf("got %q", commands)
+	}
+
+	commands, err = generateUpdateAttributeCommand(&oldValue, (*string)(nil), "server", "pbs", qmgrAttributeName("resources_available", &ncpus))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(commands) != 1 || commands[0] != `/opt/pbs/bin/qmgr -c 'unset server pbs resources_available.ncpus'` {
+		t.Errorf("got %q", commands)
+	}
+
+	commands, err = generateUpdateAttributeCommand(&oldValue, &oldValue, "server", "pbs", "scheduler_iteration")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(commands) != 0 {
+		t.Errorf("expected no commands but got %q", commands)
+	}
+}
@@ -0,0 +1,104 @@
+package pbsclient
+
+import "strings"
+
+// configLine is one line of a configuration file. Lines that don't set anything, such as comments and blank lines,
+// have no key.
+type configLine[V any] struct {
+	raw   string
+	key   string
+	value V
+}
+
+// configLines is a configuration file made up of lines that each set a key, such as sched_config or mom_priv/config.
+// Comments, blank lines and the layout of lines that aren't changed are kept exactly as they were so that rendering
+// the file only changes the keys that were set.
+type configLines[V any] []configLine[V]
+
+// parseConfigLines splits content into lines, using parse to find the key and value set by each. parse returns false
+// for lines that don't set anything.
+func parseConfigLines[V any](content string, parse func(raw string) (string, V, bool)) configLines[V] {
+	lines := configLines[V]{}
+	if content == "" {
+		return lines
+	}
+
+	for _, raw := range strings.Split(strings.TrimSuffix(content, "\n"), "\n") {
+		line := configLine[V]{raw: raw}
+		if key, value, ok := parse(raw); ok {
+			line.key = key
+			line.value = value
+		}
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// values returns every value set for key in the order they appear in the file.
+func (l configLines[V]) values(key string) []V {
+	values := []V{}
+	for _, line := range l {
+		if line.key == key {
+			values = append(values, line.value)
+		}
+	}
+
+	return values
+}
+
+// keys returns every key set in the file, in the order they first appear.
+func (l configLines[V]) keys() []string {
+	keys := []string{}
+	seen := map[string]bool{}
+	for _, line := range l {
+		if line.key != "" && !seen[line.key] {
+			seen[line.key] = true
+			keys = append(keys, line.key)
+		}
+	}
+
+	return keys
+}
+
+// set replaces every line for key with the given values, rendered with format. The new lines take the place of the
+// first existing line so that the key stays next to its comments, a key that isn't in the file yet is appended to
+// it. Setting no values removes the key.
+func (l *configLines[V]) set(key string, values []V, format func(key string, value V) string) {
+	replacement := make([]configLine[V], 0, len(values))
+	for _, v := range values {
+		replacement = append(replacement, configLine[V]{raw: format(key, v), key: key, value: v})
+	}
+
+	lines := make(configLines[V], 0, len(*l)+len(values))
+	replaced := false
+	for _, line := range *l {
+		if line.key != key {
+			lines = append(lines, line)
+			continue
+		}
+		if !replaced {
+			lines = append(lines, replacement...)
+			replaced = true
+		}
+	}
+	if !replaced {
+		lines = append(lines, replacement...)
+	}
+
+	*l = lines
+}
+
+// String renders the file.
+func (l configLines[V]) String() string {
+	if len(l) == 0 {
+		return ""
+	}
+
+	raw := make([]string, 0, len(l))
+	for _, line := range l {
+		raw = append(raw, line.raw)
+	}
+
+	return strings.Join(raw, "\n") + "\n"
+}
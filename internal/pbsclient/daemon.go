@@ -0,0 +1,154 @@
+package pbsclient
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	DaemonActionHup     = "hup"
+	DaemonActionRestart = "restart"
+)
+
+// PbsDaemons are the daemons that can be reloaded or restarted.
+var PbsDaemons = []string{"pbs_server", "pbs_sched", "pbs_comm", "pbs_mom"}
+
+// daemonProcessNames are the names the daemons run as, pbs_server is a wrapper script that execs pbs_server.bin.
+var daemonProcessNames = map[string]string{
+	"pbs_server": "pbs_server.bin",
+	"pbs_sched":  "pbs_sched",
+	"pbs_comm":   "pbs_comm",
+	"pbs_mom":    "pbs_mom",
+}
+
+// daemonStopCommands stop each daemon without touching running jobs. A quick qterm leaves jobs running and pbs_mom
+// only kills its jobs on SIGTERM, not SIGINT.
+var daemonStopCommands = map[string]string{
+	"pbs_server": "/opt/pbs/bin/qterm -t quick",
+	"pbs_sched":  "pkill -TERM -x pbs_sched",
+	"pbs_comm":   "pkill -TERM -x pbs_comm",
+	"pbs_mom":    "pkill -INT -x pbs_mom",
+}
+
+// daemonStartCommands start each daemon, pbs_mom is started with -p so it takes over the jobs that are still
+// running.
+var daemonStartCommands = map[string]string{
+	"pbs_server": "/opt/pbs/sbin/pbs_server",
+	"pbs_sched":  "/opt/pbs/sbin/pbs_sched",
+	"pbs_comm":   "/opt/pbs/sbin/pbs_comm",
+	"pbs_mom":    pbsMomCommand + " -p",
+}
+
+// hupNotRunningOutput is printed by the command from generateHupCommand when there was no process to signal.
+const hupNotRunningOutput = "no process matched"
+
+// generateHupCommand sends every process with the given name a SIGHUP. pkill exits with 1 when no process
+// matched, which is reported on stdout instead of as a failure so that it can be told apart from a real error.
+func generateHupCommand(process string) string {
+	return fmt.Sprintf("pkill -HUP -x %s; rc=$?; if [ $rc -eq 1 ]; then echo '%s'; exit 0; fi; exit $rc", escapeStringForShell(process), hupNotRunningOutput)
+}
+
+// hupMatchedProcess reports whether the command from generateHupCommand found a process to signal.
+func hupMatchedProcess(output []byte) bool {
+	return strings.TrimSpace(string(output)) != hupNotRunningOutput
+}
+
+// parsePgrepOutput returns the PID printed by pgrep -o, 0 when nothing matched.
+func parsePgrepOutput(output []byte) (int, error) {
+	value := strings.TrimSpace(string(output))
+	if value == "" {
+		return 0, nil
+	}
+
+	pid, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected pgrep output %q", value)
+	}
+
+	return pid, nil
+}
+
+// GetDaemonPid returns the PID of the daemon, 0 if it isn't running.
+func (c *PbsClient) GetDaemonPid(daemon string) (int, error) {
+	name, ok := daemonProcessNames[daemon]
+	if !ok {
+		return 0, fmt.Errorf("unknown daemon %s", daemon)
+	}
+
+	out, errOutput, err := c.runCommand("pgrep -o -x " + escapeStringForShell(name) + " || true")
+	if err != nil {
+		return 0, fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	return parsePgrepOutput(out)
+}
+
+// HupDaemon sends the daemon a SIGHUP so that it re-reads its configuration.
+func (c *PbsClient) HupDaemon(daemon string) error {
+	name, ok := daemonProcessNames[daemon]
+	if !ok {
+		return fmt.Errorf("unknown daemon %s", daemon)
+	}
+
+	out, errOutput, err := c.runCommand(generateHupCommand(name))
+	if err != nil {
+		return fmt.Errorf("%s %s", err, errOutput)
+	}
+	if !hupMatchedProcess(out) {
+		return fmt.Errorf("%s is not running", daemon)
+	}
+
+	return nil
+}
+
+// StopDaemon asks the daemon to shut down, it may take a while to exit once this returns.
+func (c *PbsClient) StopDaemon(daemon string) error {
+	cmd, ok := daemonStopCommands[daemon]
+	if !ok {
+		return fmt.Errorf("unknown daemon %s", daemon)
+	}
+
+	_, errOutput, err := c.runCommand(cmd)
+	if err != nil {
+		return fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	return nil
+}
+
+// StartDaemon starts the daemon, which detaches once it has started.
+func (c *PbsClient) StartDaemon(daemon string) error {
+	cmd, ok := daemonStartCommands[daemon]
+	if !ok {
+		return fmt.Errorf("unknown daemon %s", daemon)
+	}
+
+	_, errOutput, err := c.runCommand(cmd)
+	if err != nil {
+		return fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	return nil
+}
+
+// CheckDaemonHealthy returns the PID of the daemon if it is running and, for pbs_server, answering requests. An
+// error is returned for a daemon that isn't healthy.
+func (c *PbsClient) CheckDaemonHealthy(daemon string) (int, error) {
+	pid, err := c.GetDaemonPid(daemon)
+	if err != nil {
+		return 0, err
+	}
+	if pid == 0 {
+		return 0, fmt.Errorf("%s is not running", daemon)
+	}
+
+	if daemon == "pbs_server" {
+		_, errOutput, err := c.runCommand("/opt/pbs/bin/qstat -B")
+		if err != nil {
+			return 0, fmt.Errorf("%s %s", err, errOutput)
+		}
+	}
+
+	return pid, nil
+}
@@ -0,0 +1,56 @@
+package pbsclient
+
+import (
+	"testing"
+)
+
+func TestParsePgrepOutput(t *testing.T) {
+	tests := []struct {
+		output  string
+		want    int
+		wantErr bool
+	}{
+		{"1234\n", 1234, false},
+		{"", 0, false},
+		{"\n", 0, false},
+		{"pgrep: invalid option", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := parsePgrepOutput([]byte(tt.output))
+		if (err != nil) != tt.wantErr {
+			t.Errorf("got error %v for %q, wanted error %t", err, tt.output, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("got %d, wanted %d", got, tt.want)
+		}
+	}
+}
+
+func TestDaemonCommands(t *testing.T) {
+	for _, daemon := range PbsDaemons {
+		if _, ok := daemonProcessNames[daemon]; !ok {
+			t.Errorf("no process name for %s", daemon)
+		}
+		if _, ok := daemonStopCommands[daemon]; !ok {
+			t.Errorf("no stop command for %s", daemon)
+		}
+		if _, ok := daemonStartCommands[daemon]; !ok {
+			t.Errorf("no start command for %s", daemon)
+		}
+	}
+}
+
+func TestHupMatchedProcess(t *testing.T) {
+	if !hupMatchedProcess([]byte("")) {
+		t.Errorf("expected no output to mean a process was signalled")
+	}
+	if hupMatchedProcess([]byte(hupNotRunningOutput + "\n")) {
+		t.Errorf("expected %q to mean no process was signalled", hupNotRunningOutput)
+	}
+
+	want := "pkill -HUP -x 'pbs_sched'; rc=$?; if [ $rc -eq 1 ]; then echo 'no process matched'; exit 0; fi; exit $rc"
+	if got := generateHupCommand("pbs_sched"); got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
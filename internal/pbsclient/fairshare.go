@@ -0,0 +1,162 @@
+package pbsclient
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	DefaultResourceGroupPath = "/var/spool/pbs/sched_priv/resource_group"
+
+	// FairshareRoot is the parent of the entities at the top of the fairshare tree.
+	FairshareRoot = "root"
+
+	// fairshareUsageRoot is what pbsfs calls the root of the fairshare tree.
+	fairshareUsageRoot = "TREEROOT"
+)
+
+var fairshareUsageRegex = regexp.MustCompile(`^\s*(\S+)\s*:\s*Grp:\s*(-?\d+)\s*cgrp:\s*(-?\d+)\s*Shares:\s*(-?\d+)\s*Usage:\s*(\S+)\s*Perc:\s*(\S+)%`)
+
+// FairshareEntity is one line of the resource_group file, a user or group in the fairshare tree.
+type FairshareEntity struct {
+	Name   string
+	ID     int
+	Parent string
+	Shares int
+}
+
+// FairshareUsage is one entity in the fairshare tree as reported by pbsfs. Percentage is the entity's share of the
+// whole tree as a percentage. Parent is empty for the root of the tree.
+type FairshareUsage struct {
+	Name       string
+	ID         int
+	Parent     string
+	Shares     int
+	Usage      float64
+	Percentage float64
+}
+
+// ParseResourceGroup parses the contents of a resource_group file. Each line is an entity's name, unique ID,
+// parent and shares separated by whitespace.
+func ParseResourceGroup(content string) ([]FairshareEntity, error) {
+	entities := []FairshareEntity{}
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("line %d of resource_group must have a name, ID, parent and shares: %q", i+1, line)
+		}
+		id, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d of resource_group has an invalid ID %q", i+1, fields[1])
+		}
+		shares, err := strconv.Atoi(fields[3])
+		if err != nil {
+			return nil, fmt.Errorf("line %d of resource_group has invalid shares %q", i+1, fields[3])
+		}
+
+		entities = append(entities, FairshareEntity{Name: fields[0], ID: id, Parent: fields[2], Shares: shares})
+	}
+
+	return entities, nil
+}
+
+// FormatResourceGroup renders the entities as a resource_group file. The scheduler needs a parent to be defined
+// before its children, so entities are written top down and otherwise in the order given. Entities whose parent
+// isn't in the tree are left out, the tree must be validated first.
+func FormatResourceGroup(entities []FairshareEntity) string {
+	var b strings.Builder
+	b.WriteString("# Managed by Terraform, changes made here will be overwritten\n")
+
+	written := map[string]bool{FairshareRoot: true}
+	for progress := true; progress; {
+		progress = false
+		for _, e := range entities {
+			if written[e.Name] || !written[e.Parent] {
+				continue
+			}
+			fmt.Fprintf(&b, "%s\t%d\t%s\t%d\n", e.Name, e.ID, e.Parent, e.Shares)
+			written[e.Name] = true
+			progress = true
+		}
+	}
+
+	return b.String()
+}
+
+// parseFairshareUsageOutput parses the tree printed by pbsfs with no arguments. Each entity's Grp is the cgrp of
+// its parent, which is used to find the parent's name. The root is reported as FairshareRoot, the same name the
+// resource_group file uses.
+func parseFairshareUsageOutput(output []byte) ([]FairshareUsage, error) {
+	usage := []FairshareUsage{}
+	parents := map[int]int{}
+	names := map[int]string{}
+
+	for _, line := range strings.Split(string(output), "\n") {
+		m := fairshareUsageRegex.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+
+		parent, _ := strconv.Atoi(m[2])
+		id, _ := strconv.Atoi(m[3])
+		shares, _ := strconv.Atoi(m[4])
+		used, err := strconv.ParseFloat(m[5], 64)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse usage of %s: %s", m[1], err)
+		}
+		percentage, err := strconv.ParseFloat(m[6], 64)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse percentage of %s: %s", m[1], err)
+		}
+
+		name := m[1]
+		if name == fairshareUsageRoot {
+			name = FairshareRoot
+		}
+
+		parents[len(usage)] = parent
+		names[id] = name
+		usage = append(usage, FairshareUsage{Name: name, ID: id, Shares: shares, Usage: used, Percentage: percentage})
+	}
+
+	for i := range usage {
+		if name, ok := names[parents[i]]; ok {
+			usage[i].Parent = name
+		}
+	}
+
+	return usage, nil
+}
+
+// GetResourceGroup reads the fairshare tree from the resource_group file at path.
+func (c *PbsClient) GetResourceGroup(path string) ([]FairshareEntity, error) {
+	out, errOutput, err := c.runCommand("cat " + escapeStringForShell(path))
+	if err != nil {
+		return nil, fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	return ParseResourceGroup(string(out))
+}
+
+// UpdateResourceGroup replaces the fairshare tree in the resource_group file at path and sends pbs_sched a SIGHUP
+// so that it re-reads it.
+func (c *PbsClient) UpdateResourceGroup(path string, entities []FairshareEntity) error {
+	return c.writeSchedulerFile(path, FormatResourceGroup(entities))
+}
+
+// GetFairshareUsage returns every entity in the fairshare tree along with its current usage, as reported by pbsfs.
+func (c *PbsClient) GetFairshareUsage() ([]FairshareUsage, error) {
+	out, errOutput, err := c.runCommand("/opt/pbs/sbin/pbsfs")
+	if err != nil {
+		return nil, fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	return parseFairshareUsageOutput(out)
+}
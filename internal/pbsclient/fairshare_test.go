@@ -0,0 +1,84 @@
+package pbsclient
+
+import (
+	"testing"
+)
+
+func TestParseResourceGroup(t *testing.T) {
+	content := `# name   id   parent   shares
+physics	100	root	60
+
+theory 101 physics 20
+alice	1001	theory	1
+`
+	entities, err := ParseResourceGroup(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []FairshareEntity{
+		{Name: "physics", ID: 100, Parent: "root", Shares: 60},
+		{Name: "theory", ID: 101, Parent: "physics", Shares: 20},
+		{Name: "alice", ID: 1001, Parent: "theory", Shares: 1},
+	}
+	if len(entities) != len(want) {
+		t.Fatalf("got %d entities, wanted %d", len(entities), len(want))
+	}
+	for i := range want {
+		if entities[i] != want[i] {
+			t.Errorf("got %+v, wanted %+v", entities[i], want[i])
+		}
+	}
+
+	if _, err := ParseResourceGroup("physics 100 root\n"); err == nil {
+		t.Errorf("expected an error for a line without shares")
+	}
+}
+
+func TestFormatResourceGroup(t *testing.T) {
+	// Children are given before their parents but must be written after them
+	got := FormatResourceGroup([]FairshareEntity{
+		{Name: "alice", ID: 1001, Parent: "theory", Shares: 1},
+		{Name: "theory", ID: 101, Parent: "physics", Shares: 20},
+		{Name: "chemistry", ID: 200, Parent: "root", Shares: 40},
+		{Name: "physics", ID: 100, Parent: "root", Shares: 60},
+	})
+
+	want := `# Managed by Terraform, changes made here will be overwritten
+chemistry	200	root	40
+physics	100	root	60
+theory	101	physics	20
+alice	1001	theory	1
+`
+	if got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func TestParseFairshareUsageOutput(t *testing.T) {
+	output := `Fairshare usage units are in: cput
+TREEROOT  : Grp: -1     cgrp: 0     Shares: -1     Usage: 5012   Perc: 100.000%
+unknown   : Grp: 0      cgrp: 1     Shares: 0      Usage: 1      Perc:   0.000%
+physics   : Grp: 0      cgrp: 100   Shares: 60     Usage: 4000   Perc:  60.000%
+ theory   : Grp: 100    cgrp: 101   Shares: 20     Usage: 1011   Perc:  60.000%
+`
+	usage, err := parseFairshareUsageOutput([]byte(output))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []FairshareUsage{
+		{Name: FairshareRoot, ID: 0, Shares: -1, Usage: 5012, Percentage: 100},
+		{Name: "unknown", ID: 1, Parent: FairshareRoot, Shares: 0, Usage: 1, Percentage: 0},
+		{Name: "physics", ID: 100, Parent: FairshareRoot, Shares: 60, Usage: 4000, Percentage: 60},
+		{Name: "theory", ID: 101, Parent: "physics", Shares: 20, Usage: 1011, Percentage: 60},
+	}
+	if len(usage) != len(want) {
+		t.Fatalf("got %d entities, wanted %d", len(usage), len(want))
+	}
+	for i := range want {
+		if usage[i] != want[i] {
+			t.Errorf("got %+v, wanted %+v", usage[i], want[i])
+		}
+	}
+}
@@ -215,3 +215,92 @@ func (c *PbsClient) DeleteHook(name string) error {
 
 	return nil
 }
+
+// getBuiltinHookFieldDefinitions returns the ordered list of attributes which can be tuned on a built-in
+// (type=pbs) hook. Type, event and user are fixed by PBS for built-in hooks so are not included.
+func getBuiltinHookFieldDefinitions() []hookFieldDefinition {
+	return []hookFieldDefinition{
+		{"alarm", 10, func(h PbsHook) any { return h.Alarm }},
+		{"debug", 10, func(h PbsHook) any { return h.Debug }},
+		{"fail_action", 10, func(h PbsHook) any { return h.FailAction }},
+		{"freq", 10, func(h PbsHook) any { return h.Freq }},
+		{"order", 10, func(h PbsHook) any { return h.Order }},
+		{"enabled", 90, func(h PbsHook) any { return h.Enabled }},
+	}
+}
+
+// generateBuiltinHookCommands produces the qmgr directives needed to move the managed attributes of a
+// built-in hook from oldHook to newHook. Attributes which are nil in both are left untouched so values
+// not managed by terraform are never reset, attributes which become nil are unset (restoring the PBS default).
+func generateBuiltinHookCommands(oldHook PbsHook, newHook PbsHook) ([]string, error) {
+	var commands = []string{}
+
+	fieldDefs := getBuiltinHookFieldDefinitions()
+	sort.Slice(fieldDefs, func(i, j int) bool {
+		return fieldDefs[i].order < fieldDefs[j].order
+	})
+
+	for _, fieldDef := range fieldDefs {
+		oldValue := fieldDef.getValue(oldHook)
+		newValue := fieldDef.getValue(newHook)
+		newCommands, err := generateUpdateAttributeCommand(oldValue, newValue, "pbshook", newHook.Name, fieldDef.attribute)
+		if err != nil {
+			return nil, err
+		}
+		commands = append(commands, newCommands...)
+	}
+
+	return commands, nil
+}
+
+func (c *PbsClient) GetBuiltinHook(name string) (PbsHook, error) {
+	all, err := c.GetBuiltinHooks()
+	if err != nil {
+		return PbsHook{}, err
+	}
+
+	for _, r := range all {
+		if r.Name == name {
+			return r, nil
+		}
+	}
+
+	return PbsHook{}, nil
+}
+
+func (c *PbsClient) GetBuiltinHooks() ([]PbsHook, error) {
+	out, errOutput, err := c.runCommand("/opt/pbs/bin/qmgr -c 'list pbshook @default'")
+	if err != nil {
+		return nil, fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	return parseHookOutput(out)
+}
+
+// UpdateBuiltinHook applies the difference between the previously managed attributes (oldHook) and the
+// desired attributes (newHook) to a built-in hook. Built-in hooks can't be created or deleted so this is
+// the only way they are modified.
+func (c *PbsClient) UpdateBuiltinHook(oldHook PbsHook, newHook PbsHook) (PbsHook, error) {
+	commands, err := generateBuiltinHookCommands(oldHook, newHook)
+	if err != nil {
+		return PbsHook{}, err
+	}
+
+	_, errOutput, err := c.runCommands(commands)
+	if err != nil {
+		completeErrOutput := ""
+		for _, e := range errOutput {
+			completeErrOutput += string(e)
+		}
+		return PbsHook{}, fmt.Errorf("%s %s %s", err, completeErrOutput, strings.Join(commands, ","))
+	}
+
+	return c.GetBuiltinHook(newHook.Name)
+}
+
+// ResetBuiltinHook unsets every attribute which is set on managedHook, returning those attributes on the
+// built-in hook to their PBS defaults.
+func (c *PbsClient) ResetBuiltinHook(managedHook PbsHook) error {
+	_, err := c.UpdateBuiltinHook(managedHook, PbsHook{Name: managedHook.Name})
+	return err
+}
@@ -0,0 +1,94 @@
+package pbsclient
+
+import "testing"
+
+func TestParseBuiltinHookOutput(t *testing.T) {
+	sourceText := `Hook PBS_power
+    type = pbs
+    enabled = false
+    event = exechost_periodic,exechost_startup
+    user = pbsadmin
+    alarm = 180
+    freq = 300
+    order = 2000
+    debug = false
+    fail_action = none`
+
+	hooks, err := parseHookOutput([]byte(sourceText))
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if len(hooks) != 1 {
+		t.Errorf("expected 1 hook but got %d", len(hooks))
+		return
+	}
+	if hooks[0].Name != "PBS_power" {
+		t.Errorf("got %q, wanted %q", hooks[0].Name, "PBS_power")
+	}
+	if *hooks[0].Type != "pbs" {
+		t.Errorf("got %q, wanted %q", *hooks[0].Type, "pbs")
+	}
+	if *hooks[0].Order != 2000 {
+		t.Errorf("got %d, wanted %d", *hooks[0].Order, 2000)
+	}
+	if *hooks[0].Enabled {
+		t.Errorf("expected hook to be disabled")
+	}
+}
+
+func TestGenerateBuiltinHookCommands(t *testing.T) {
+	enabled := true
+	oldAlarm := int32(30)
+	newAlarm := int32(60)
+	freq := int32(300)
+
+	testCases := []struct {
+		desc     string
+		oldHook  PbsHook
+		newHook  PbsHook
+		expected []string
+	}{
+		{
+			desc:     "nothing managed produces no commands",
+			oldHook:  PbsHook{Name: "PBS_power"},
+			newHook:  PbsHook{Name: "PBS_power"},
+			expected: []string{},
+		},
+		{
+			desc:    "newly managed attributes are set with enabled last",
+			oldHook: PbsHook{Name: "PBS_power"},
+			newHook: PbsHook{Name: "PBS_power", Enabled: &enabled, Freq: &freq},
+			expected: []string{
+				"/opt/pbs/bin/qmgr -c 'set pbshook PBS_power freq=300'",
+				"/opt/pbs/bin/qmgr -c 'set pbshook PBS_power enabled=true'",
+			},
+		},
+		{
+			desc:    "changed attributes are set and dropped attributes are unset",
+			oldHook: PbsHook{Name: "PBS_power", Alarm: &oldAlarm, Freq: &freq},
+			newHook: PbsHook{Name: "PBS_power", Alarm: &newAlarm},
+			expected: []string{
+				"/opt/pbs/bin/qmgr -c 'set pbshook PBS_power alarm=60'",
+				"/opt/pbs/bin/qmgr -c 'unset pbshook PBS_power freq'",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		commands, err := generateBuiltinHookCommands(tc.oldHook, tc.newHook)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.desc, err)
+			continue
+		}
+		if len(commands) != len(tc.expected) {
+			t.Errorf("%s: expected %d commands but got %d (%v)", tc.desc, len(tc.expected), len(commands), commands)
+			continue
+		}
+		for i, command := range commands {
+			if command != tc.expected[i] {
+				t.Errorf("%s: got %q, wanted %q", tc.desc, command, tc.expected[i])
+			}
+		}
+	}
+}
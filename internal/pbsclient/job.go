@@ -0,0 +1,145 @@
+package pbsclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type PbsJob struct {
+	ID                 string
+	Name               string
+	Owner              string
+	Queue              string
+	State              string
+	ResourcesRequested map[string]string
+	ResourcesUsed      map[string]string
+	ExecHost           *string
+}
+
+// qstatJobOutput is the subset of a job in the output of qstat -f -F json that is exposed.
+type qstatJobOutput struct {
+	JobName       string         `json:"Job_Name"`
+	JobOwner      string         `json:"Job_Owner"`
+	JobState      string         `json:"job_state"`
+	Queue         string         `json:"queue"`
+	ResourceList  map[string]any `json:"Resource_List"`
+	ResourcesUsed map[string]any `json:"resources_used"`
+	ExecHost      *string        `json:"exec_host"`
+}
+
+type qstatOutput struct {
+	Jobs map[string]json.RawMessage `json:"Jobs"`
+}
+
+// parseJobOutput parses the output of qstat -f -F json. The Jobs key is left out entirely by qstat when there are no
+// jobs, and some versions print nothing at all, so both are treated as an empty list. Jobs are sorted by their sequence number.
+func parseJobOutput(output []byte) ([]PbsJob, error) {
+	jobs := []PbsJob{}
+	if len(bytes.TrimSpace(output)) == 0 {
+		return jobs, nil
+	}
+
+	var parsed qstatOutput
+	if err := json.Unmarshal(output, &parsed); err != nil {
+		return nil, fmt.Errorf("unable to parse qstat output: %s", err)
+	}
+
+	for id, raw := range parsed.Jobs {
+		// Resource values are a mix of strings and numbers, decode numbers as written so that they can be
+		// returned as strings without being reformatted as floats
+		var j qstatJobOutput
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		decoder.UseNumber()
+		if err := decoder.Decode(&j); err != nil {
+			return nil, fmt.Errorf("unable to parse job %s: %s", id, err)
+		}
+
+		jobs = append(jobs, PbsJob{
+			ID:                 id,
+			Name:               j.JobName,
+			Owner:              j.JobOwner,
+			Queue:              j.Queue,
+			State:              j.JobState,
+			ResourcesRequested: formatJobResources(j.ResourceList),
+			ResourcesUsed:      formatJobResources(j.ResourcesUsed),
+			ExecHost:           j.ExecHost,
+		})
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		a, b := jobSequenceNumber(jobs[i].ID), jobSequenceNumber(jobs[j].ID)
+		if a != b {
+			return a < b
+		}
+		return jobs[i].ID < jobs[j].ID
+	})
+
+	return jobs, nil
+}
+
+// jobSequenceNumber returns the leading sequence number of a job ID, e.g. 12 for "12.pbs" or "12[].pbs".
+func jobSequenceNumber(id string) int {
+	end := strings.IndexFunc(id, func(r rune) bool { return r < '0' || r > '9' })
+	if end < 0 {
+		end = len(id)
+	}
+	n, _ := strconv.Atoi(id[:end])
+	return n
+}
+
+func formatJobResources(resources map[string]any) map[string]string {
+	if len(resources) == 0 {
+		return nil
+	}
+
+	result := make(map[string]string, len(resources))
+	for k, v := range resources {
+		result[k] = fmt.Sprint(v)
+	}
+
+	return result
+}
+
+// User returns the owner of the job without the submission host, e.g. "alice" for "alice@login01".
+func (j PbsJob) User() string {
+	user, _, _ := strings.Cut(j.Owner, "@")
+	return user
+}
+
+// GetJobs returns the jobs known to the server, or only the given jobs if any IDs are passed. Jobs which don't
+// exist, or have finished and are only kept in the job history, are left out rather than being treated as an
+// error.
+func (c *PbsClient) GetJobs(jobIDs []string) ([]PbsJob, error) {
+	args := []string{"/opt/pbs/bin/qstat", "-f", "-F", "json"}
+	for _, id := range jobIDs {
+		args = append(args, escapeStringForShell(id))
+	}
+
+	out, errOutput, err := c.runCommand(strings.Join(args, " "))
+	if err != nil && !onlyUnknownJobErrors(string(errOutput)) {
+		return nil, fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	return parseJobOutput(out)
+}
+
+// onlyUnknownJobErrors reports whether every line qstat wrote to stderr is about a job which doesn't exist. With
+// job history enabled qstat reports "Job has finished, use -x or -H to obtain historical job information" for
+// a finished job instead, which is treated the same way.
+func onlyUnknownJobErrors(errOutput string) bool {
+	if strings.TrimSpace(errOutput) == "" {
+		return false
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(errOutput), "\n") {
+		if !strings.Contains(line, "Unknown Job Id") && !strings.Contains(line, "Job has finished") {
+			return false
+		}
+	}
+
+	return true
+}
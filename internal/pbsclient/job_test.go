@@ -0,0 +1,98 @@
+package pbsclient
+
+import (
+	"testing"
+)
+
+func TestParseJobOutput(t *testing.T) {
+	jobs, err := parseJobOutput([]byte(`{
+    "timestamp":1700000000,
+    "pbs_version":"23.06.06",
+    "pbs_server":"pbs",
+    "Jobs":{
+        "12.pbs":{
+            "Job_Name":"train",
+            "Job_Owner":"alice@login01",
+            "job_state":"R",
+            "queue":"workq",
+            "exec_host":"node01/0*4",
+            "Resource_List":{
+                "ncpus":4,
+                "mem":"8gb",
+                "select":"1:ncpus=4:mem=8gb"
+            },
+            "resources_used":{
+                "cput":"00:10:00",
+                "ncpus":4
+            }
+        },
+        "3.pbs":{
+            "Job_Name":"STDIN",
+            "Job_Owner":"bob@login02",
+            "job_state":"Q",
+            "queue":"workq",
+            "Resource_List":{
+                "walltime":"01:00:00"
+            }
+        }
+    }
+}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 2 {
+		t.Fatalf("expected 2 jobs but got %d", len(jobs))
+	}
+
+	running := jobs[1]
+	if running.ID != "12.pbs" || running.Name != "train" || running.State != "R" || running.Queue != "workq" {
+		t.Errorf("unexpected job %+v", running)
+	}
+	if got, want := running.User(), "alice"; got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+	if running.ExecHost == nil || *running.ExecHost != "node01/0*4" {
+		t.Errorf("expected exec_host node01/0*4 but got %v", running.ExecHost)
+	}
+	if got, want := running.ResourcesRequested["ncpus"], "4"; got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+	if got, want := running.ResourcesUsed["cput"], "00:10:00"; got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+
+	queued := jobs[0]
+	if queued.ExecHost != nil {
+		t.Errorf("expected no exec_host but got %q", *queued.ExecHost)
+	}
+	if queued.ResourcesUsed != nil {
+		t.Errorf("expected no resources_used but got %v", queued.ResourcesUsed)
+	}
+}
+
+func TestParseJobOutputEmpty(t *testing.T) {
+	for _, output := range []string{"", "\n", `{"timestamp":1700000000,"pbs_version":"23.06.06","pbs_server":"pbs"}`} {
+		jobs, err := parseJobOutput([]byte(output))
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %s", output, err)
+		}
+		if len(jobs) != 0 {
+			t.Errorf("expected no jobs for %q but got %d", output, len(jobs))
+		}
+	}
+}
+
+func TestOnlyUnknownJobErrors(t *testing.T) {
+	if !onlyUnknownJobErrors("qstat: Unknown Job Id 99.pbs\nqstat: Unknown Job Id 100.pbs\n") {
+		t.Errorf("expected unknown job errors to be ignored")
+	}
+	if !onlyUnknownJobErrors("qstat: 98.pbs Job has finished, use -x or -H to obtain historical job information\nqstat: Unknown Job Id 99.pbs\n") {
+		t.Errorf("expected finished job errors to be ignored")
+	}
+	if onlyUnknownJobErrors("qstat: Unknown Job Id 99.pbs\nConnection refused\n") {
+		t.Errorf("expected other errors not to be ignored")
+	}
+	if onlyUnknownJobErrors("") {
+		t.Errorf("expected a failure without any output not to be ignored")
+	}
+}
@@ -0,0 +1,104 @@
+package pbsclient
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const DefaultMomConfigPath = "/var/spool/pbs/mom_priv/config"
+
+// ErrMomNotRunning is returned when a MoM file has been changed but pbs_mom wasn't running to be told about it. The
+// file is read when pbs_mom next starts so callers will usually only warn about it.
+var ErrMomNotRunning = errors.New("pbs_mom is not running, the change takes effect when it is started")
+
+// MomConfig is a parsed mom_priv/config file. Each line is a directive such as "$clienthost pbs" or a static
+// resource such as "ngpus 4". As with SchedConfig, comments and lines that aren't changed are kept exactly as they
+// were.
+type MomConfig struct {
+	lines configLines[string]
+}
+
+// ParseMomConfig parses the contents of a MoM configuration file.
+func ParseMomConfig(content string) MomConfig {
+	return MomConfig{lines: parseConfigLines(content, parseMomConfigLine)}
+}
+
+// parseMomConfigLine returns the directive set by a line of the MoM configuration, which is false for comments and
+// blank lines.
+func parseMomConfigLine(raw string) (string, string, bool) {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+		return "", "", false
+	}
+	if i := strings.IndexAny(trimmed, " \t"); i >= 0 {
+		return trimmed[:i], strings.TrimSpace(trimmed[i:]), true
+	}
+
+	return trimmed, "", true
+}
+
+// formatMomConfigLine renders a directive with its value separated by a space.
+func formatMomConfigLine(key string, value string) string {
+	return key + " " + value
+}
+
+// Values returns every value set for the directive, e.g. "$clienthost", in the order they appear in the file.
+func (c MomConfig) Values(key string) []string {
+	return c.lines.values(key)
+}
+
+// Set replaces every line for the directive with the given values in the place of the first existing line, a
+// directive that isn't in the file yet is appended to it. Setting no values removes the directive.
+func (c *MomConfig) Set(key string, values []string) {
+	c.lines.set(key, values, formatMomConfigLine)
+}
+
+// String renders the file.
+func (c MomConfig) String() string {
+	return c.lines.String()
+}
+
+// GetMomConfig reads the MoM configuration file at path. The client must be connected to the MoM's host, see
+// ForHost.
+func (c *PbsClient) GetMomConfig(path string) (MomConfig, error) {
+	out, errOutput, err := c.runCommand("cat " + escapeStringForShell(path))
+	if err != nil {
+		return MomConfig{}, fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	return ParseMomConfig(string(out)), nil
+}
+
+// EditMomConfig reads the MoM configuration file at path, applies edit to it and, if that changed anything,
+// writes it back and sends pbs_mom a SIGHUP so that it re-reads it. ErrMomNotRunning is returned if the file was
+// written but pbs_mom isn't running. The edited configuration is returned.
+func (c *PbsClient) EditMomConfig(path string, edit func(*MomConfig)) (MomConfig, error) {
+	config, err := c.GetMomConfig(path)
+	if err != nil {
+		return config, err
+	}
+
+	original := config.String()
+	edit(&config)
+	if config.String() == original {
+		return config, nil
+	}
+
+	output, errOutput, err := c.runCommands([]string{
+		generateWriteFileCommand(path, config.String()),
+		generateHupCommand("pbs_mom"),
+	})
+	if err != nil {
+		completeErrOutput := ""
+		for _, e := range errOutput {
+			completeErrOutput += string(e)
+		}
+		return config, fmt.Errorf("%s %s", err, completeErrOutput)
+	}
+	if !hupMatchedProcess(output[1]) {
+		return config, ErrMomNotRunning
+	}
+
+	return config, nil
+}
@@ -0,0 +1,47 @@
+package pbsclient
+
+import (
+	"testing"
+)
+
+const testMomConfig = `# MoM configuration
+$clienthost pbs
+$restrict_user_maxsysid 999
+$usecp	*.example.com:/home /home
+ngpus 4
+`
+
+func TestParseMomConfig(t *testing.T) {
+	config := ParseMomConfig(testMomConfig)
+
+	if got := config.String(); got != testMomConfig {
+		t.Errorf("rendering an unchanged file changed it, got %q", got)
+	}
+	if got := config.Values("$usecp"); len(got) != 1 || got[0] != "*.example.com:/home /home" {
+		t.Errorf("got %q, wanted %q", got, "*.example.com:/home /home")
+	}
+	if got := config.Values("ngpus"); len(got) != 1 || got[0] != "4" {
+		t.Errorf("got %q, wanted %q", got, "4")
+	}
+	if got := ParseMomConfig("").String(); got != "" {
+		t.Errorf("got %q, wanted an empty file", got)
+	}
+}
+
+func TestMomConfigSet(t *testing.T) {
+	config := ParseMomConfig(testMomConfig)
+	config.Set("$clienthost", []string{"pbs", "pbs-backup"})
+	config.Set("$restrict_user_maxsysid", nil)
+	config.Set("$restrict_user", []string{"True"})
+
+	want := `# MoM configuration
+$clienthost pbs
+$clienthost pbs-backup
+$usecp	*.example.com:/home /home
+ngpus 4
+$restrict_user True
+`
+	if got := config.String(); got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
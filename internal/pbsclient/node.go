@@ -2,6 +2,7 @@ package pbsclient
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -342,3 +343,100 @@ func (c *PbsClient) DeleteNode(name string) error {
 
 	return nil
 }
+
+// GetNodeAttribute returns the value of a single node attribute exactly as reported by qmgr, or nil if it is
+// not set. The returned bool is false if the node doesn't exist.
+func (c *PbsClient) GetNodeAttribute(nodeName string, attribute string, resource *string) (*string, bool, error) {
+	return c.getQmgrObjectAttribute("node", nodeName, attribute, resource)
+}
+
+// UpdateNodeAttribute sets a single node attribute without touching any other attribute. Passing a nil value
+// unsets the attribute.
+func (c *PbsClient) UpdateNodeAttribute(nodeName string, attribute string, resource *string, value *string) error {
+	return c.updateQmgrObjectAttribute("node", nodeName, attribute, resource, value)
+}
+
+// States returns the individual states of the node, e.g. "job-busy" and "offline" for "job-busy,offline".
+func (n PbsNode) States() []string {
+	return splitListAttribute(n.State)
+}
+
+// IsOffline reports whether the node has been marked offline, e.g. with pbsnodes -o.
+func (n PbsNode) IsOffline() bool {
+	return slices.Contains(n.States(), "offline")
+}
+
+// RunningJobs returns the IDs of the jobs running on the node. The jobs attribute lists one entry per
+// allocated cpu (e.g. "12.pbs/0, 12.pbs/1") so IDs are de-duplicated.
+func (n PbsNode) RunningJobs() []string {
+	jobs := []string{}
+	seen := map[string]bool{}
+	for _, entry := range splitListAttribute(n.Jobs) {
+		id, _, _ := strings.Cut(entry, "/")
+		if !seen[id] {
+			seen[id] = true
+			jobs = append(jobs, id)
+		}
+	}
+
+	return jobs
+}
+
+// generateNodeOfflineCommand marks a node offline with pbsnodes -o, optionally setting the comment to the
+// reason. Running jobs are left alone but no new jobs are scheduled on the node.
+func generateNodeOfflineCommand(name string, comment *string) string {
+	if comment != nil {
+		return fmt.Sprintf("/opt/pbs/bin/pbsnodes -o -C %s %s", escapeStringForShell(*comment), escapeStringForShell(name))
+	}
+
+	return fmt.Sprintf("/opt/pbs/bin/pbsnodes -o %s", escapeStringForShell(name))
+}
+
+// SetNodeOffline marks a node offline, optionally recording the reason in the node comment.
+func (c *PbsClient) SetNodeOffline(name string, comment *string) error {
+	_, errOutput, err := c.runCommand(generateNodeOfflineCommand(name, comment))
+	if err != nil {
+		return fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	return nil
+}
+
+// ClearNodeOffline returns an offline node to service with pbsnodes -r. The comment set when the node was
+// taken offline is unset if clearComment is true.
+func (c *PbsClient) ClearNodeOffline(name string, clearComment bool) error {
+	commands := []string{fmt.Sprintf("/opt/pbs/bin/pbsnodes -r %s", escapeStringForShell(name))}
+	if clearComment {
+		commands = append(commands, fmt.Sprintf("/opt/pbs/bin/qmgr -c 'unset node %s comment'", name))
+	}
+
+	_, errOutput, err := c.runCommands(commands)
+	if err != nil {
+		completeErrOutput := ""
+		for _, e := range errOutput {
+			completeErrOutput += string(e)
+		}
+		return fmt.Errorf("%s %s %s", err, completeErrOutput, strings.Join(commands, ","))
+	}
+
+	return nil
+}
+
+// RerunJobs requeues the given jobs with qrerun so that they can be started again elsewhere.
+func (c *PbsClient) RerunJobs(jobIDs []string) error {
+	if len(jobIDs) == 0 {
+		return nil
+	}
+
+	args := make([]string, 0, len(jobIDs))
+	for _, id := range jobIDs {
+		args = append(args, escapeStringForShell(id))
+	}
+
+	_, errOutput, err := c.runCommand("/opt/pbs/bin/qrerun " + strings.Join(args, " "))
+	if err != nil {
+		return fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	return nil
+}
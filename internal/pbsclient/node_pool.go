@@ -0,0 +1,210 @@
+package pbsclient
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// qmgrBatchSize is the maximum number of directives sent to a single qmgr invocation.
+const qmgrBatchSize = 500
+
+// generateQmgrBatchCommand runs several qmgr directives in a single qmgr invocation by passing them on stdin.
+// The heredoc delimiter is quoted so the directives are passed through without any shell expansion.
+func generateQmgrBatchCommand(directives []string) string {
+	return fmt.Sprintf("/opt/pbs/bin/qmgr <<'QMGR_EOF'\n%s\nQMGR_EOF", strings.Join(directives, "\n"))
+}
+
+// runQmgrDirectives runs the directives in batches of qmgrBatchSize so that thousands of changes only need a
+// handful of qmgr invocations over a single SSH connection.
+func (c *PbsClient) runQmgrDirectives(directives []string) error {
+	if len(directives) == 0 {
+		return nil
+	}
+
+	var commands []string
+	for start := 0; start < len(directives); start += qmgrBatchSize {
+		end := min(start+qmgrBatchSize, len(directives))
+		commands = append(commands, generateQmgrBatchCommand(directives[start:end]))
+	}
+
+	output, errOutput, err := c.runCommands(commands)
+	if err != nil {
+		completeErrOutput := ""
+		for _, e := range errOutput {
+			completeErrOutput += string(e)
+		}
+		for _, o := range output {
+			completeErrOutput += string(o)
+		}
+		return fmt.Errorf("%s %s", err, completeErrOutput)
+	}
+
+	return nil
+}
+
+// generateNodePoolStringDirectives returns the directive to move a string attribute to its desired value. The
+// attribute is only touched if the pool manages it now or managed it previously.
+func generateNodePoolStringDirectives(name string, attribute string, previous *string, current *string, desired *string) []string {
+	if desired == nil {
+		if previous != nil && current != nil {
+			return []string{fmt.Sprintf("unset node %s %s", name, attribute)}
+		}
+		return nil
+	}
+	if current == nil || *current != *desired {
+		return []string{fmt.Sprintf("set node %s %s=%s", name, attribute, escapeStringForQmgr(*desired))}
+	}
+
+	return nil
+}
+
+func int32PointerToString(value *int32) *string {
+	if value == nil {
+		return nil
+	}
+	s := strconv.Itoa(int(*value))
+	return &s
+}
+
+// generateNodePoolDirectives returns the qmgr directives needed to bring a single node in a pool from its
+// current state to the desired one. Only the attributes the pool manages (queue, partition, priority and the
+// configured resources_available keys) are touched, everything else reported by the MoM is left alone. A nil
+// current node is created.
+func generateNodePoolDirectives(previous *PbsNode, current *PbsNode, desired PbsNode) []string {
+	name := desired.Name
+	directives := []string{}
+	if previous == nil {
+		previous = &PbsNode{}
+	}
+	if current == nil {
+		directives = append(directives, fmt.Sprintf("create node %s", name))
+		current = &PbsNode{}
+	}
+
+	directives = append(directives, generateNodePoolStringDirectives(name, "queue", previous.Queue, current.Queue, desired.Queue)...)
+	directives = append(directives, generateNodePoolStringDirectives(name, "partition", previous.Partition, current.Partition, desired.Partition)...)
+
+	directives = append(directives, generateNodePoolStringDirectives(name, "priority", int32PointerToString(previous.Priority), int32PointerToString(current.Priority), int32PointerToString(desired.Priority))...)
+
+	keys := []string{}
+	for k := range desired.ResourcesAvailable {
+		keys = append(keys, k)
+	}
+	for k := range previous.ResourcesAvailable {
+		if _, ok := desired.ResourcesAvailable[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		var previousValue, currentValue, desiredValue *string
+		if v, ok := previous.ResourcesAvailable[k]; ok {
+			previousValue = &v
+		}
+		if v, ok := current.ResourcesAvailable[k]; ok {
+			currentValue = &v
+		}
+		if v, ok := desired.ResourcesAvailable[k]; ok {
+			desiredValue = &v
+		}
+		directives = append(directives, generateNodePoolStringDirectives(name, "resources_available."+k, previousValue, currentValue, desiredValue)...)
+	}
+
+	return directives
+}
+
+// NodeMatchesPool reports whether a node has every attribute the pool manages set to its desired value.
+func NodeMatchesPool(current PbsNode, desired PbsNode) bool {
+	return len(generateNodePoolDirectives(nil, &current, desired)) == 0
+}
+
+// GetNodePoolMembers returns the nodes from the desired pool members that exist on the server, keyed by name,
+// using a single list node read.
+func (c *PbsClient) GetNodePoolMembers(desired []PbsNode) (map[string]PbsNode, error) {
+	all, err := c.GetNodes()
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[string]bool, len(desired))
+	for _, n := range desired {
+		wanted[n.Name] = true
+	}
+
+	members := map[string]PbsNode{}
+	for _, n := range all {
+		if wanted[n.Name] {
+			members[n.Name] = n
+		}
+	}
+
+	return members, nil
+}
+
+// ReconcileNodePool brings every node in the pool to its desired state with batched qmgr directives computed
+// against a single list node read. Missing nodes are created and nodes which were previously in the pool but
+// are no longer desired are deleted. previous is the desired state from the last apply and is used to unset
+// attributes which are no longer managed, it is empty when the pool is first created.
+func (c *PbsClient) ReconcileNodePool(previous []PbsNode, desired []PbsNode) error {
+	all, err := c.GetNodes()
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]PbsNode, len(all))
+	for _, n := range all {
+		current[n.Name] = n
+	}
+	previousByName := make(map[string]PbsNode, len(previous))
+	for _, n := range previous {
+		previousByName[n.Name] = n
+	}
+	desiredNames := make(map[string]bool, len(desired))
+
+	directives := []string{}
+	for _, d := range desired {
+		desiredNames[d.Name] = true
+
+		var p, cur *PbsNode
+		if n, ok := previousByName[d.Name]; ok {
+			p = &n
+		}
+		if n, ok := current[d.Name]; ok {
+			cur = &n
+		}
+		directives = append(directives, generateNodePoolDirectives(p, cur, d)...)
+	}
+
+	for _, p := range previous {
+		if _, ok := current[p.Name]; ok && !desiredNames[p.Name] {
+			directives = append(directives, fmt.Sprintf("delete node %s", p.Name))
+		}
+	}
+
+	return c.runQmgrDirectives(directives)
+}
+
+// DeleteNodePool deletes every node in the pool which still exists using batched qmgr directives.
+func (c *PbsClient) DeleteNodePool(names []string) error {
+	all, err := c.GetNodes()
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]bool, len(all))
+	for _, n := range all {
+		existing[n.Name] = true
+	}
+
+	directives := []string{}
+	for _, name := range names {
+		if existing[name] {
+			directives = append(directives, fmt.Sprintf("delete node %s", name))
+		}
+	}
+
+	return c.runQmgrDirectives(directives)
+}
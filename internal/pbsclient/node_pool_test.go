@@ -0,0 +1,89 @@
+package pbsclient
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestGenerateNodePoolDirectivesCreate(t *testing.T) {
+	queue := "workq"
+	priority := int32(10)
+	desired := PbsNode{
+		Name:               "cn001",
+		Queue:              &queue,
+		Priority:           &priority,
+		ResourcesAvailable: map[string]string{"ngpus": "4", "mem": "256gb"},
+	}
+
+	got := generateNodePoolDirectives(nil, nil, desired)
+	want := []string{
+		"create node cn001",
+		`set node cn001 queue="workq"`,
+		`set node cn001 priority="10"`,
+		`set node cn001 resources_available.mem="256gb"`,
+		`set node cn001 resources_available.ngpus="4"`,
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func TestGenerateNodePoolDirectivesUpdate(t *testing.T) {
+	oldQueue := "workq"
+	newQueue := "gpu"
+	partition := "p1"
+	previous := PbsNode{
+		Name:               "cn001",
+		Queue:              &oldQueue,
+		Partition:          &partition,
+		ResourcesAvailable: map[string]string{"ngpus": "4", "scratch": "1tb"},
+	}
+	current := PbsNode{
+		Name:      "cn001",
+		Queue:     &oldQueue,
+		Partition: &partition,
+		// ncpus and host are reported by the MoM and not managed by the pool
+		ResourcesAvailable: map[string]string{"ngpus": "4", "scratch": "1tb", "ncpus": "64", "host": "cn001"},
+	}
+	desired := PbsNode{
+		Name:               "cn001",
+		Queue:              &newQueue,
+		ResourcesAvailable: map[string]string{"ngpus": "8"},
+	}
+
+	got := generateNodePoolDirectives(&previous, &current, desired)
+	want := []string{
+		`set node cn001 queue="gpu"`,
+		"unset node cn001 partition",
+		`set node cn001 resources_available.ngpus="8"`,
+		"unset node cn001 resources_available.scratch",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+
+	// Attributes which were never managed by the pool are left alone
+	if got := generateNodePoolDirectives(nil, &current, PbsNode{Name: "cn001"}); len(got) != 0 {
+		t.Errorf("expected no directives but got %q", got)
+	}
+}
+
+func TestNodeMatchesPool(t *testing.T) {
+	queue := "workq"
+	current := PbsNode{Name: "cn001", Queue: &queue, ResourcesAvailable: map[string]string{"ngpus": "4", "ncpus": "64"}}
+
+	if !NodeMatchesPool(current, PbsNode{Name: "cn001", Queue: &queue, ResourcesAvailable: map[string]string{"ngpus": "4"}}) {
+		t.Errorf("expected node to match pool")
+	}
+	if NodeMatchesPool(current, PbsNode{Name: "cn001", ResourcesAvailable: map[string]string{"ngpus": "8"}}) {
+		t.Errorf("expected node not to match pool")
+	}
+}
+
+func TestGenerateQmgrBatchCommand(t *testing.T) {
+	got := generateQmgrBatchCommand([]string{"create node cn001", `set node cn001 comment="it's here"`})
+	want := "/opt/pbs/bin/qmgr <<'QMGR_EOF'\ncreate node cn001\nset node cn001 comment=\"it's here\"\nQMGR_EOF"
+	if got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
@@ -0,0 +1,52 @@
+package pbsclient
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseNodeOutputStateAndJobs(t *testing.T) {
+	nodes, err := parseNodeOutput([]byte(`Node node01
+    Mom = node01
+    Port = 15002
+    state = job-busy,offline
+    jobs = 12.pbs/0, 12.pbs/1, 13.pbs/2
+    resv_enable = True
+    comment = disk replacement
+
+Node node02
+    Mom = node02
+    Port = 15002
+    state = free
+    resv_enable = True`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nodes) != 2 {
+		t.Fatalf("expected 2 nodes but got %d", len(nodes))
+	}
+
+	if !nodes[0].IsOffline() {
+		t.Errorf("expected node01 to be offline")
+	}
+	if got, want := nodes[0].RunningJobs(), []string{"12.pbs", "13.pbs"}; !slices.Equal(got, want) {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+
+	if nodes[1].IsOffline() {
+		t.Errorf("expected node02 not to be offline")
+	}
+	if got := nodes[1].RunningJobs(); len(got) != 0 {
+		t.Errorf("expected no running jobs but got %q", got)
+	}
+}
+
+func TestGenerateNodeOfflineCommand(t *testing.T) {
+	comment := "bob's disk replacement"
+	if got, want := generateNodeOfflineCommand("node01", &comment), `/opt/pbs/bin/pbsnodes -o -C 'bob'\''s disk replacement' 'node01'`; got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+	if got, want := generateNodeOfflineCommand("node01", nil), `/opt/pbs/bin/pbsnodes -o 'node01'`; got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
@@ -0,0 +1,90 @@
+package pbsclient
+
+import (
+	"fmt"
+	"strings"
+)
+
+const DefaultPbsConfPath = "/etc/pbs.conf"
+
+// PbsConf is a parsed pbs.conf file of KEY=VALUE lines. As with MomConfig, comments and lines that aren't changed
+// are kept exactly as they were.
+type PbsConf struct {
+	lines configLines[string]
+}
+
+// ParsePbsConf parses the contents of a pbs.conf file.
+func ParsePbsConf(content string) PbsConf {
+	return PbsConf{lines: parseConfigLines(content, parsePbsConfLine)}
+}
+
+// parsePbsConfLine returns the key set by a line of pbs.conf, which is false for comments and lines without an =.
+func parsePbsConfLine(raw string) (string, string, bool) {
+	trimmed := strings.TrimSpace(raw)
+	key, value, ok := strings.Cut(trimmed, "=")
+	if !ok || strings.HasPrefix(trimmed, "#") {
+		return "", "", false
+	}
+
+	return strings.TrimSpace(key), strings.TrimSpace(value), true
+}
+
+// formatPbsConfLine renders a KEY=VALUE line.
+func formatPbsConfLine(key string, value string) string {
+	return key + "=" + value
+}
+
+// Get returns the value of key. pbs.conf is sourced by the init script, so if the key is set more than once the
+// last value is the one used.
+func (c PbsConf) Get(key string) (string, bool) {
+	values := c.lines.values(key)
+	if len(values) == 0 {
+		return "", false
+	}
+
+	return values[len(values)-1], true
+}
+
+// Set replaces every line for key with one setting it to value in the place of the first existing line, a key that
+// isn't in the file yet is appended to it.
+func (c *PbsConf) Set(key string, value string) {
+	c.lines.set(key, []string{value}, formatPbsConfLine)
+}
+
+// String renders the file.
+func (c PbsConf) String() string {
+	return c.lines.String()
+}
+
+// GetPbsConf reads the pbs.conf file at path.
+func (c *PbsClient) GetPbsConf(path string) (PbsConf, error) {
+	out, errOutput, err := c.runCommand("cat " + escapeStringForShell(path))
+	if err != nil {
+		return PbsConf{}, fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	return ParsePbsConf(string(out)), nil
+}
+
+// EditPbsConf reads the pbs.conf file at path, applies edit to it and, if that changed anything, writes it back. The
+// daemons only read pbs.conf when they start so none are signalled, the second value reports whether the file
+// changed and so whether they need restarting. The edited file is returned.
+func (c *PbsClient) EditPbsConf(path string, edit func(*PbsConf)) (PbsConf, bool, error) {
+	conf, err := c.GetPbsConf(path)
+	if err != nil {
+		return conf, false, err
+	}
+
+	original := conf.String()
+	edit(&conf)
+	if conf.String() == original {
+		return conf, false, nil
+	}
+
+	_, errOutput, err := c.runCommand(generateWriteFileCommand(path, conf.String()))
+	if err != nil {
+		return conf, false, fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	return conf, true, nil
+}
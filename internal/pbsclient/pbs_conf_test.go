@@ -0,0 +1,46 @@
+package pbsclient
+
+import (
+	"testing"
+)
+
+const testPbsConf = `PBS_EXEC=/opt/pbs
+PBS_SERVER=pbs
+PBS_START_SERVER=1
+PBS_START_SCHED=1
+PBS_START_COMM=1
+PBS_START_MOM=0
+PBS_HOME=/var/spool/pbs
+# PBS_LEAF_ROUTERS=comm1
+PBS_CORE_LIMIT=unlimited
+PBS_SCP=/usr/bin/scp
+`
+
+func TestParsePbsConf(t *testing.T) {
+	conf := ParsePbsConf(testPbsConf)
+
+	if got := conf.String(); got != testPbsConf {
+		t.Errorf("rendering an unchanged file changed it, got %q", got)
+	}
+	if got, ok := conf.Get("PBS_SERVER"); !ok || got != "pbs" {
+		t.Errorf("got %q, wanted %q", got, "pbs")
+	}
+	// Commented out keys aren't set
+	if got, ok := conf.Get("PBS_LEAF_ROUTERS"); ok {
+		t.Errorf("got %q, wanted PBS_LEAF_ROUTERS to be unset", got)
+	}
+	if got, _ := ParsePbsConf("PBS_START_MOM=0\nPBS_START_MOM=1\n").Get("PBS_START_MOM"); got != "1" {
+		t.Errorf("got %q, wanted the last value %q", got, "1")
+	}
+}
+
+func TestPbsConfSet(t *testing.T) {
+	conf := ParsePbsConf("PBS_SERVER=pbs\nPBS_START_MOM=0\n# comment\nPBS_START_MOM=0\n")
+	conf.Set("PBS_START_MOM", "1")
+	conf.Set("PBS_LEAF_ROUTERS", "comm1,comm2")
+
+	want := "PBS_SERVER=pbs\nPBS_START_MOM=1\n# comment\nPBS_LEAF_ROUTERS=comm1,comm2\n"
+	if got := conf.String(); got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
@@ -0,0 +1,191 @@
+package pbsclient
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultHolidaysPath      = "/var/spool/pbs/sched_priv/holidays"
+	DefaultDedicatedTimePath = "/var/spool/pbs/sched_priv/dedicated_time"
+
+	// HolidayDateFormat is how a holiday's date is given, the holidays file itself uses the day of the year and
+	// e.g. "Jan 1".
+	HolidayDateFormat = "2006-01-02"
+	// DedicatedTimeFormat is how the start and end of dedicated time are given. They are in the server's local
+	// time as the dedicated_time file has no timezone.
+	DedicatedTimeFormat = "2006-01-02T15:04"
+
+	dedicatedTimeFileFormat = "01/02/2006 15:04"
+)
+
+// PrimeTimeDays are the days that prime time can be set for, weekday covers Monday to Friday.
+var PrimeTimeDays = []string{"weekday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+// PrimeTimeSchedule is when prime and non-prime time start on a day, as HHMM or all or none.
+type PrimeTimeSchedule struct {
+	Day           string
+	PrimeStart    string
+	NonPrimeStart string
+}
+
+// Holiday is a day which is non-prime all day.
+type Holiday struct {
+	Date time.Time
+	Name string
+}
+
+// Holidays is the contents of the holidays file, which sets when prime time is for the year.
+type Holidays struct {
+	Year      int
+	Schedules []PrimeTimeSchedule
+	Holidays  []Holiday
+}
+
+// DedicatedTime is a window when only jobs in dedicated time queues are run.
+type DedicatedTime struct {
+	Start time.Time
+	End   time.Time
+}
+
+// ParseHolidays parses the contents of a holidays file. Lines starting with * are comments. Holidays are written as
+// the day of the year, the date and a name, e.g. "1  Jan 1  New Year's Day", the day of the year is optional.
+func ParseHolidays(content string) (Holidays, error) {
+	h := Holidays{Schedules: []PrimeTimeSchedule{}, Holidays: []Holiday{}}
+
+	for i, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "*") {
+			continue
+		}
+
+		switch {
+		case strings.EqualFold(fields[0], "YEAR") && len(fields) == 2:
+			year, err := strconv.Atoi(fields[1])
+			if err != nil {
+				return h, fmt.Errorf("line %d of holidays has an invalid year %q", i+1, fields[1])
+			}
+			h.Year = year
+		case isPrimeTimeDay(fields[0]):
+			if len(fields) != 3 {
+				return h, fmt.Errorf("line %d of holidays must have a day, prime start and non-prime start: %q", i+1, line)
+			}
+			h.Schedules = append(h.Schedules, PrimeTimeSchedule{Day: strings.ToLower(fields[0]), PrimeStart: fields[1], NonPrimeStart: fields[2]})
+		default:
+			// Skip the day of the year, it is worked out from the date
+			if _, err := strconv.Atoi(fields[0]); err == nil {
+				fields = fields[1:]
+			}
+			if len(fields) < 2 {
+				return h, fmt.Errorf("line %d of holidays must have a date such as \"Jan 1\": %q", i+1, line)
+			}
+			date, err := time.Parse("Jan 2 2006", fmt.Sprintf("%s %s %d", fields[0], fields[1], h.Year))
+			if err != nil {
+				return h, fmt.Errorf("line %d of holidays has an invalid date: %q", i+1, line)
+			}
+			h.Holidays = append(h.Holidays, Holiday{Date: date, Name: strings.Join(fields[2:], " ")})
+		}
+	}
+
+	return h, nil
+}
+
+// isPrimeTimeDay reports whether value is one of the days prime time can be set for.
+func isPrimeTimeDay(value string) bool {
+	for _, day := range PrimeTimeDays {
+		if strings.EqualFold(value, day) {
+			return true
+		}
+	}
+	return false
+}
+
+// FormatHolidays renders a holidays file.
+func FormatHolidays(h Holidays) string {
+	var b strings.Builder
+	b.WriteString("* Managed by Terraform, changes made here will be overwritten\n")
+	fmt.Fprintf(&b, "YEAR\t%d\n", h.Year)
+	b.WriteString("*\n*\tDay\tPrime\tNon-Prime\n*\n")
+	for _, s := range h.Schedules {
+		fmt.Fprintf(&b, "\t%s\t%s\t%s\n", s.Day, s.PrimeStart, s.NonPrimeStart)
+	}
+	b.WriteString("*\n*\tDay of Year\tDate\tHoliday\n*\n")
+	for _, holiday := range h.Holidays {
+		fmt.Fprintf(&b, "\t%d\t%s\t%s\n", holiday.Date.YearDay(), holiday.Date.Format("Jan 2"), holiday.Name)
+	}
+
+	return b.String()
+}
+
+// ParseDedicatedTime parses the contents of a dedicated_time file. Each line is a window written as
+// "MM/DD/YYYY HH:MM MM/DD/YYYY HH:MM", lines starting with # or * are comments.
+func ParseDedicatedTime(content string) ([]DedicatedTime, error) {
+	windows := []DedicatedTime{}
+
+	for i, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") || strings.HasPrefix(fields[0], "*") {
+			continue
+		}
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("line %d of dedicated_time must have a start and end: %q", i+1, line)
+		}
+
+		start, err := time.Parse(dedicatedTimeFileFormat, fields[0]+" "+fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d of dedicated_time has an invalid start: %q", i+1, line)
+		}
+		end, err := time.Parse(dedicatedTimeFileFormat, fields[2]+" "+fields[3])
+		if err != nil {
+			return nil, fmt.Errorf("line %d of dedicated_time has an invalid end: %q", i+1, line)
+		}
+
+		windows = append(windows, DedicatedTime{Start: start, End: end})
+	}
+
+	return windows, nil
+}
+
+// FormatDedicatedTime renders a dedicated_time file.
+func FormatDedicatedTime(windows []DedicatedTime) string {
+	var b strings.Builder
+	b.WriteString("# Managed by Terraform, changes made here will be overwritten\n")
+	b.WriteString("# FROM\t\t\tTO\n# MM/DD/YYYY HH:MM\tMM/DD/YYYY HH:MM\n")
+	for _, w := range windows {
+		fmt.Fprintf(&b, "%s\t%s\n", w.Start.Format(dedicatedTimeFileFormat), w.End.Format(dedicatedTimeFileFormat))
+	}
+
+	return b.String()
+}
+
+// GetHolidays reads the holidays file at path.
+func (c *PbsClient) GetHolidays(path string) (Holidays, error) {
+	out, errOutput, err := c.runCommand("cat " + escapeStringForShell(path))
+	if err != nil {
+		return Holidays{}, fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	return ParseHolidays(string(out))
+}
+
+// UpdateHolidays replaces the holidays file at path and sends pbs_sched a SIGHUP so that it re-reads it.
+func (c *PbsClient) UpdateHolidays(path string, h Holidays) error {
+	return c.writeSchedulerFile(path, FormatHolidays(h))
+}
+
+// GetDedicatedTime reads the dedicated time windows from the dedicated_time file at path.
+func (c *PbsClient) GetDedicatedTime(path string) ([]DedicatedTime, error) {
+	out, errOutput, err := c.runCommand("cat " + escapeStringForShell(path))
+	if err != nil {
+		return nil, fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	return ParseDedicatedTime(string(out))
+}
+
+// UpdateDedicatedTime replaces the dedicated_time file at path and sends pbs_sched a SIGHUP so that it re-reads it.
+func (c *PbsClient) UpdateDedicatedTime(path string, windows []DedicatedTime) error {
+	return c.writeSchedulerFile(path, FormatDedicatedTime(windows))
+}
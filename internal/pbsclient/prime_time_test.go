@@ -0,0 +1,95 @@
+package pbsclient
+
+import (
+	"testing"
+	"time"
+)
+
+const testHolidays = `*
+* Prime/Nonprime Table
+*
+YEAR	2026
+*
+*	Prime	Non-Prime
+* Day	Start	Start
+*
+	weekday	0600	1730
+	saturday	none	all
+	sunday	none	all
+*
+* Day of	Calendar	Company
+* Year		Date		Holiday
+*
+	1	Jan 1	New Year's Day
+	359	Dec 25	Christmas Day
+Dec 26	Boxing Day
+`
+
+func TestParseHolidays(t *testing.T) {
+	h, err := ParseHolidays(testHolidays)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if h.Year != 2026 {
+		t.Errorf("got %d, wanted %d", h.Year, 2026)
+	}
+	if len(h.Schedules) != 3 || h.Schedules[0] != (PrimeTimeSchedule{Day: "weekday", PrimeStart: "0600", NonPrimeStart: "1730"}) {
+		t.Errorf("unexpected schedules %+v", h.Schedules)
+	}
+	if len(h.Holidays) != 3 {
+		t.Fatalf("got %d holidays, wanted 3", len(h.Holidays))
+	}
+	if got, want := h.Holidays[0].Name, "New Year's Day"; got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+	if got, want := h.Holidays[2].Date, time.Date(2026, 12, 26, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("got %s, wanted %s", got, want)
+	}
+
+	if _, err := ParseHolidays("YEAR 2026\nweekday 0600\n"); err == nil {
+		t.Errorf("expected an error for a day without a non-prime start")
+	}
+}
+
+func TestFormatHolidaysRoundTrip(t *testing.T) {
+	h, err := ParseHolidays(testHolidays)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	formatted := FormatHolidays(h)
+	again, err := ParseHolidays(formatted)
+	if err != nil {
+		t.Fatalf("unexpected error parsing %q: %s", formatted, err)
+	}
+	if FormatHolidays(again) != formatted {
+		t.Errorf("got %q, wanted %q", FormatHolidays(again), formatted)
+	}
+	if got, want := len(again.Holidays), 3; got != want {
+		t.Errorf("got %d, wanted %d", got, want)
+	}
+}
+
+func TestParseDedicatedTime(t *testing.T) {
+	content := `# FROM			TO
+# MM/DD/YYYY HH:MM	MM/DD/YYYY HH:MM
+11/02/2026 08:00	11/02/2026 17:30
+`
+	windows, err := ParseDedicatedTime(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := DedicatedTime{
+		Start: time.Date(2026, 11, 2, 8, 0, 0, 0, time.UTC),
+		End:   time.Date(2026, 11, 2, 17, 30, 0, 0, time.UTC),
+	}
+	if len(windows) != 1 || !windows[0].Start.Equal(want.Start) || !windows[0].End.Equal(want.End) {
+		t.Errorf("got %+v, wanted %+v", windows, want)
+	}
+
+	if got, want := FormatDedicatedTime(windows), "# Managed by Terraform, changes made here will be overwritten\n# FROM\t\t\tTO\n# MM/DD/YYYY HH:MM\tMM/DD/YYYY HH:MM\n11/02/2026 08:00\t11/02/2026 17:30\n"; got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
@@ -122,6 +122,12 @@ type PbsQueue struct {
 	RouteRetryTime         *int32
 	RouteWaitingJobs       *bool
 	Started                bool
+
+	// Runtime statistics reported by the server, these can't be set.
+	HasNodes          *bool
+	ResourcesAssigned map[string]string
+	StateCount        map[string]int
+	TotalJobs         *int32
 }
 
 func parseQueueOutput(output []byte) ([]PbsQueue, error) {
@@ -302,6 +308,21 @@ func parseQueueOutput(output []byte) ([]PbsQueue, error) {
 							return nil, fmt.Errorf("failed to convert %s value to bool %s", k, err.Error())
 						}
 						current.Started = boolValue
+					case "hasnodes":
+						boolValue, err := strconv.ParseBool(s)
+						if err != nil {
+							return nil, fmt.Errorf("failed to convert %s value to bool %s", k, err.Error())
+						}
+						current.HasNodes = &boolValue
+					case "state_count":
+						current.StateCount = parseStateCount(s)
+					case "total_jobs":
+						intValue, err := strconv.Atoi(s)
+						if err != nil {
+							return nil, fmt.Errorf("failed to convert %s value to int %s", k, err.Error())
+						}
+						i32Value := int32(intValue)
+						current.TotalJobs = &i32Value
 					default:
 						// TODO - What to do with attributes we don't recognise?
 					}
@@ -331,6 +352,8 @@ func parseQueueOutput(output []byte) ([]PbsQueue, error) {
 						current.ResourcesMax = a
 					case "resources_min":
 						current.ResourcesMin = a
+					case "resources_assigned":
+						current.ResourcesAssigned = a
 					}
 				}
 			}
@@ -464,3 +487,163 @@ func (client *PbsClient) DeleteQueue(name string) error {
 
 	return nil
 }
+
+// getQueueListAttribute returns a pointer to the list valued queue attribute with the given qmgr name.
+func getQueueListAttribute(queue PbsQueue, attribute string) (*string, error) {
+	switch attribute {
+	case "acl_groups":
+		return queue.AclGroups, nil
+	case "acl_hosts":
+		return queue.AclHosts, nil
+	case "acl_users":
+		return queue.AclUsers, nil
+	default:
+		return nil, fmt.Errorf("unsupported queue list attribute %s", attribute)
+	}
+}
+
+// GetQueueListEntries returns the individual entries of a list valued queue attribute such as acl_users. The
+// returned bool is false if the queue doesn't exist.
+func (client *PbsClient) GetQueueListEntries(queueName string, attribute string) ([]string, bool, error) {
+	queue, err := client.GetQueue(queueName)
+	if err != nil {
+		return nil, false, err
+	}
+	if queue.Name == "" {
+		return nil, false, nil
+	}
+
+	value, err := getQueueListAttribute(queue, attribute)
+	if err != nil {
+		return nil, true, err
+	}
+
+	return splitListAttribute(value), true, nil
+}
+
+// AddQueueListEntry adds a single entry to a list valued queue attribute using the += operator, leaving
+// any other entries untouched. Adding an entry which is already present is a no-op.
+func (client *PbsClient) AddQueueListEntry(queueName string, attribute string, entry string) error {
+	queue, err := client.GetQueue(queueName)
+	if err != nil {
+		return err
+	}
+	if queue.Name == "" {
+		return fmt.Errorf("queue %s does not exist", queueName)
+	}
+
+	value, err := getQueueListAttribute(queue, attribute)
+	if err != nil {
+		return err
+	}
+	if _, ok := FindListEntry(splitListAttribute(value), entry); ok {
+		return nil
+	}
+
+	_, errOutput, err := client.runCommand(generateListEntryCommand("queue", queueName, attribute, "+=", entry))
+	if err != nil {
+		return fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	return nil
+}
+
+// RemoveQueueListEntry removes a single entry from a list valued queue attribute using the -= operator,
+// leaving any other entries untouched. Removing an entry which isn't present (or from a queue which no
+// longer exists) is a no-op.
+func (client *PbsClient) RemoveQueueListEntry(queueName string, attribute string, entry string) error {
+	queue, err := client.GetQueue(queueName)
+	if err != nil {
+		return err
+	}
+	if queue.Name == "" {
+		return nil
+	}
+
+	value, err := getQueueListAttribute(queue, attribute)
+	if err != nil {
+		return err
+	}
+	stored, ok := FindListEntry(splitListAttribute(value), entry)
+	if !ok {
+		return nil
+	}
+
+	_, errOutput, err := client.runCommand(generateListEntryCommand("queue", queueName, attribute, "-=", stored))
+	if err != nil {
+		return fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	return nil
+}
+
+// GetQueueAttribute returns the value of a single queue attribute exactly as reported by qmgr, or nil if it is
+// not set. The returned bool is false if the queue doesn't exist.
+func (client *PbsClient) GetQueueAttribute(queueName string, attribute string, resource *string) (*string, bool, error) {
+	return client.getQmgrObjectAttribute("queue", queueName, attribute, resource)
+}
+
+// UpdateQueueAttribute sets a single queue attribute without touching any other attribute. Passing a nil value
+// unsets the attribute.
+func (client *PbsClient) UpdateQueueAttribute(queueName string, attribute string, resource *string, value *string) error {
+	return client.updateQmgrObjectAttribute("queue", queueName, attribute, resource, value)
+}
+
+// parseStateCount parses a state_count attribute such as "Transit:0 Queued:3 Held:0 Running:1" into the number
+// of jobs in each state. The server's license_count attribute uses the same format.
+func parseStateCount(value string) map[string]int {
+	counts := map[string]int{}
+	for _, field := range strings.Fields(value) {
+		state, countText, ok := strings.Cut(field, ":")
+		if !ok {
+			continue
+		}
+		count, err := strconv.Atoi(countText)
+		if err != nil {
+			continue
+		}
+		counts[state] = count
+	}
+
+	return counts
+}
+
+// GetQueueJobCounts returns the total number of jobs in a queue and the number of jobs in each state. The
+// returned bool is false if the queue doesn't exist.
+func (client *PbsClient) GetQueueJobCounts(queueName string) (int, map[string]int, bool, error) {
+	queue, err := client.GetQueue(queueName)
+	if err != nil || queue.Name == "" {
+		return 0, nil, false, err
+	}
+
+	total := 0
+	if queue.TotalJobs != nil {
+		total = int(*queue.TotalJobs)
+	}
+
+	return total, queue.StateCount, true, nil
+}
+
+// MoveQueueJobs moves every job in the queue which has not started running to the destination queue with
+// qmove. Running jobs can't be moved and are left to finish.
+func (client *PbsClient) MoveQueueJobs(queueName string, destination string) error {
+	out, errOutput, err := client.runCommand(fmt.Sprintf("/opt/pbs/bin/qselect -q %s -s HQW", escapeStringForShell(queueName)))
+	if err != nil {
+		return fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	args := []string{}
+	for _, id := range strings.Fields(string(out)) {
+		args = append(args, escapeStringForShell(id))
+	}
+	if len(args) == 0 {
+		return nil
+	}
+
+	_, errOutput, err = client.runCommand(fmt.Sprintf("/opt/pbs/bin/qmove %s %s", escapeStringForShell(destination), strings.Join(args, " ")))
+	if err != nil {
+		return fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	return nil
+}
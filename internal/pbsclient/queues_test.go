@@ -0,0 +1,51 @@
+package pbsclient
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestParseStateCount(t *testing.T) {
+	got := parseStateCount("Transit:0 Queued:3 Held:1 Waiting:0 Running:2 Exiting:0 Begun:0 ")
+	want := map[string]int{"Transit": 0, "Queued": 3, "Held": 1, "Waiting": 0, "Running": 2, "Exiting": 0, "Begun": 0}
+	if !maps.Equal(got, want) {
+		t.Errorf("got %v, wanted %v", got, want)
+	}
+
+	if got := parseStateCount(""); len(got) != 0 {
+		t.Errorf("expected no states but got %v", got)
+	}
+}
+
+func TestParseQueueOutputRuntimeStatistics(t *testing.T) {
+	queues, err := parseQueueOutput([]byte(`Queue workq
+    queue_type = Execution
+    total_jobs = 4
+    state_count = Transit:0 Queued:3 Held:0 Waiting:0 Running:1 Exiting:0 Begun:0
+    resources_assigned.mem = 2gb
+    resources_assigned.ncpus = 2
+    resources_assigned.nodect = 1
+    hasnodes = True
+    enabled = True
+    started = True`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queues) != 1 {
+		t.Fatalf("expected 1 queue but got %d", len(queues))
+	}
+
+	q := queues[0]
+	if q.TotalJobs == nil || *q.TotalJobs != 4 {
+		t.Errorf("got total_jobs %v, wanted 4", q.TotalJobs)
+	}
+	if q.StateCount["Queued"] != 3 || q.StateCount["Running"] != 1 {
+		t.Errorf("got state_count %v", q.StateCount)
+	}
+	if got, want := q.ResourcesAssigned, map[string]string{"mem": "2gb", "ncpus": "2", "nodect": "1"}; !maps.Equal(got, want) {
+		t.Errorf("got %v, wanted %v", got, want)
+	}
+	if q.HasNodes == nil || !*q.HasNodes {
+		t.Errorf("expected hasnodes to be true")
+	}
+}
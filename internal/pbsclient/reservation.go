@@ -0,0 +1,289 @@
+package pbsclient
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// pbsRsubTimeLayout is the [[[[CC]YY]MM]DD]hhmm[.SS] format taken by pbs_rsub -R/-E and pbs_ralter
+	pbsRsubTimeLayout = "200601021504.05"
+	// pbsRstatTimeLayout is the ctime style format used by pbs_rstat -f
+	pbsRstatTimeLayout = "Mon Jan _2 15:04:05 2006"
+
+	// ReservationStateUnconfirmed and ReservationStateBeingAltered are the states a reservation is in while it waits
+	// for the scheduler to accept a new reservation or a change to an existing one
+	ReservationStateUnconfirmed  = "RESV_UNCONFIRMED"
+	ReservationStateBeingAltered = "RESV_BEING_ALTERED"
+)
+
+var (
+	reservationIDRegex        = regexp.MustCompile(`^\s*Resv ID:\s*(\S+)\s*$`)
+	reservationAttributeRegex = regexp.MustCompile(`^\s*(\w+)(?:\.([\w\-]+))?\s*=\s*(.*)$`)
+	rsubOutputRegex           = regexp.MustCompile(`(?m)^(\S+)\s+(UNCONFIRMED|CONFIRMED|DENIED)\s*$`)
+)
+
+// PbsReservation is an advance or standing reservation. Times are always handled in UTC, the commands are run with
+// TZ=UTC so that the server's local timezone never changes how a time is interpreted.
+type PbsReservation struct {
+	ID              string
+	Name            *string
+	Owner           *string
+	Start           time.Time
+	End             time.Time
+	Duration        time.Duration
+	Select          *string
+	Rrule           *string
+	Timezone        *string
+	AuthorizedUsers *string
+	Queue           *string
+	State           *string
+	Nodes           *string
+	// Hosts makes this a maintenance reservation which takes the whole of each host, it is only used on creation
+	Hosts []string
+}
+
+// IsStanding reports whether the reservation recurs.
+func (r PbsReservation) IsStanding() bool {
+	return r.Rrule != nil && *r.Rrule != ""
+}
+
+// NodeNames returns the names of the vnodes assigned to the reservation from resv_nodes, e.g. node01 and node02 for
+// "(node01:ncpus=4)+(node02:ncpus=4)".
+func (r PbsReservation) NodeNames() []string {
+	names := []string{}
+	if r.Nodes == nil {
+		return names
+	}
+
+	for _, chunk := range strings.Split(*r.Nodes, "+") {
+		name, _, _ := strings.Cut(strings.Trim(chunk, "()"), ":")
+		if name != "" && !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// parseReservationOutput parses the output of pbs_rstat -f.
+func parseReservationOutput(output []byte) ([]PbsReservation, error) {
+	reservations := []PbsReservation{}
+	var current *PbsReservation
+
+	for _, line := range strings.Split(string(output), "\n") {
+		if m := reservationIDRegex.FindStringSubmatch(line); m != nil {
+			if current != nil {
+				reservations = append(reservations, *current)
+			}
+			current = &PbsReservation{ID: m[1]}
+			continue
+		}
+
+		m := reservationAttributeRegex.FindStringSubmatch(line)
+		if m == nil || current == nil {
+			continue
+		}
+
+		attribute, resource, value := m[1], m[2], strings.TrimSpace(m[3])
+		if resource != "" {
+			if attribute == "Resource_List" && resource == "select" {
+				current.Select = &value
+			}
+			continue
+		}
+
+		switch attribute {
+		case "Reserve_Name":
+			// pbs_rstat reports an unnamed reservation as NULL
+			if value != "NULL" {
+				current.Name = &value
+			}
+		case "Reserve_Owner":
+			current.Owner = &value
+		case "reserve_state":
+			current.State = &value
+		case "reserve_start":
+			t, err := time.ParseInLocation(pbsRstatTimeLayout, value, time.UTC)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse reserve_start of %s: %s", current.ID, err)
+			}
+			current.Start = t
+		case "reserve_end":
+			t, err := time.ParseInLocation(pbsRstatTimeLayout, value, time.UTC)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse reserve_end of %s: %s", current.ID, err)
+			}
+			current.End = t
+		case "reserve_duration":
+			seconds, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse reserve_duration of %s: %s", current.ID, err)
+			}
+			current.Duration = time.Duration(seconds) * time.Second
+		case "reserve_rrule":
+			current.Rrule = &value
+		case "reserve_timezone":
+			current.Timezone = &value
+		case "Authorized_Users":
+			current.AuthorizedUsers = &value
+		case "queue":
+			current.Queue = &value
+		case "resv_nodes":
+			current.Nodes = &value
+		}
+	}
+
+	if current != nil {
+		reservations = append(reservations, *current)
+	}
+
+	return reservations, nil
+}
+
+// reservationCommandPrefix sets the timezone used to interpret times. A standing reservation also needs PBS_TZID so
+// that its occurrences are calculated in the timezone it was booked in, e.g. across daylight saving changes.
+func reservationCommandPrefix(timezone *string) string {
+	prefix := "TZ=UTC "
+	if timezone != nil && *timezone != "" {
+		prefix += "PBS_TZID=" + escapeStringForShell(*timezone) + " "
+	}
+	return prefix
+}
+
+// generateReservationSubmitCommand returns the pbs_rsub command which creates the reservation. The end time is used
+// if it is set, otherwise the duration. A maintenance reservation lists its hosts last as --hosts takes every
+// remaining argument.
+func generateReservationSubmitCommand(r PbsReservation) string {
+	args := []string{"/opt/pbs/bin/pbs_rsub", "-R", r.Start.UTC().Format(pbsRsubTimeLayout)}
+	if !r.End.IsZero() {
+		args = append(args, "-E", r.End.UTC().Format(pbsRsubTimeLayout))
+	} else {
+		args = append(args, "-D", strconv.FormatInt(int64(r.Duration/time.Second), 10))
+	}
+	if r.Select != nil {
+		args = append(args, "-l", escapeStringForShell("select="+*r.Select))
+	}
+	if r.Name != nil {
+		args = append(args, "-N", escapeStringForShell(*r.Name))
+	}
+	if r.AuthorizedUsers != nil {
+		args = append(args, "-U", escapeStringForShell(*r.AuthorizedUsers))
+	}
+	if r.IsStanding() {
+		args = append(args, "-r", escapeStringForShell(*r.Rrule))
+	}
+	if len(r.Hosts) > 0 {
+		args = append(args, "--hosts")
+		for _, host := range r.Hosts {
+			args = append(args, escapeStringForShell(host))
+		}
+	}
+
+	return reservationCommandPrefix(r.Timezone) + strings.Join(args, " ")
+}
+
+// generateReservationAlterCommand returns the pbs_ralter command which changes current into desired, or an empty string if
+// nothing pbs_ralter can change is different.
+func generateReservationAlterCommand(current PbsReservation, desired PbsReservation) string {
+	args := []string{}
+	if !current.Start.Equal(desired.Start) {
+		args = append(args, "-R", desired.Start.UTC().Format(pbsRsubTimeLayout))
+	}
+	if !desired.End.IsZero() {
+		if !current.End.Equal(desired.End) {
+			args = append(args, "-E", desired.End.UTC().Format(pbsRsubTimeLayout))
+		}
+	} else if current.Duration != desired.Duration {
+		args = append(args, "-D", strconv.FormatInt(int64(desired.Duration/time.Second), 10))
+	}
+	if desired.Select != nil && (current.Select == nil || *current.Select != *desired.Select) {
+		args = append(args, "-l", escapeStringForShell("select="+*desired.Select))
+	}
+	if desired.Name != nil && (current.Name == nil || *current.Name != *desired.Name) {
+		args = append(args, "-N", escapeStringForShell(*desired.Name))
+	}
+	if desired.AuthorizedUsers != nil && (current.AuthorizedUsers == nil || *current.AuthorizedUsers != *desired.AuthorizedUsers) {
+		args = append(args, "-U", escapeStringForShell(*desired.AuthorizedUsers))
+	}
+	if len(args) == 0 {
+		return ""
+	}
+
+	args = append(args, escapeStringForShell(desired.ID))
+	return reservationCommandPrefix(desired.Timezone) + "/opt/pbs/bin/pbs_ralter " + strings.Join(args, " ")
+}
+
+// GetReservation returns the reservation with the given ID. The returned bool is false if it doesn't exist, which
+// includes a reservation that was denied by the scheduler.
+func (c *PbsClient) GetReservation(id string) (PbsReservation, bool, error) {
+	out, errOutput, err := c.runCommand("TZ=UTC /opt/pbs/bin/pbs_rstat -f " + escapeStringForShell(id))
+	if err != nil {
+		if strings.Contains(string(errOutput), "Unknown Reservation") {
+			return PbsReservation{}, false, nil
+		}
+		return PbsReservation{}, false, fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	reservations, err := parseReservationOutput(out)
+	if err != nil {
+		return PbsReservation{}, false, err
+	}
+	for _, r := range reservations {
+		if r.ID == id {
+			return r, true, nil
+		}
+	}
+
+	return PbsReservation{}, false, nil
+}
+
+// CreateReservation submits the reservation with pbs_rsub and returns its ID. The reservation starts off unconfirmed
+// until the scheduler finds room for it.
+func (c *PbsClient) CreateReservation(r PbsReservation) (string, error) {
+	out, errOutput, err := c.runCommand(generateReservationSubmitCommand(r))
+	if err != nil {
+		return "", fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	m := rsubOutputRegex.FindStringSubmatch(string(out))
+	if m == nil {
+		return "", fmt.Errorf("unable to find the reservation ID in the pbs_rsub output %q", string(out))
+	}
+	if m[2] == "DENIED" {
+		return "", fmt.Errorf("reservation %s was denied by the scheduler", m[1])
+	}
+
+	return m[1], nil
+}
+
+// UpdateReservation changes the reservation in place with pbs_ralter. As with a new reservation the scheduler has
+// to confirm the change, if it can't then the reservation is left as it was.
+func (c *PbsClient) UpdateReservation(current PbsReservation, desired PbsReservation) error {
+	cmd := generateReservationAlterCommand(current, desired)
+	if cmd == "" {
+		return nil
+	}
+
+	_, errOutput, err := c.runCommand(cmd)
+	if err != nil {
+		return fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	return nil
+}
+
+// DeleteReservation deletes the reservation with pbs_rdel, any jobs still in its queue are deleted with it. A
+// reservation which no longer exists is not an error.
+func (c *PbsClient) DeleteReservation(id string) error {
+	_, errOutput, err := c.runCommand("/opt/pbs/bin/pbs_rdel " + escapeStringForShell(id))
+	if err != nil && !strings.Contains(string(errOutput), "Unknown Reservation") {
+		return fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	return nil
+}
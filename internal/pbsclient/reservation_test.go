@@ -0,0 +1,139 @@
+package pbsclient
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParseReservationOutput(t *testing.T) {
+	reservations, err := parseReservationOutput([]byte(`Resv ID: R12.pbs
+Reserve_Name = training
+Reserve_Owner = alice@login01
+reserve_type = 2
+reserve_state = RESV_CONFIRMED
+reserve_substate = 2
+reserve_start = Mon Nov  2 09:00:00 2026
+reserve_end = Mon Nov  2 17:00:00 2026
+reserve_duration = 28800
+queue = R12
+Resource_List.ncpus = 8
+Resource_List.select = 2:ncpus=4
+resv_nodes = (node01:ncpus=4)+(node02:ncpus=4)
+Authorized_Users = alice,bob
+
+Resv ID: S13.pbs
+Reserve_Name = NULL
+reserve_state = RESV_UNCONFIRMED
+reserve_start = Tue Nov  3 09:00:00 2026
+reserve_end = Tue Nov  3 10:00:00 2026
+reserve_duration = 3600
+reserve_rrule = FREQ=WEEKLY;COUNT=4
+reserve_timezone = Europe/London
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reservations) != 2 {
+		t.Fatalf("expected 2 reservations but got %d", len(reservations))
+	}
+
+	r := reservations[0]
+	if r.ID != "R12.pbs" || r.Name == nil || *r.Name != "training" {
+		t.Errorf("unexpected reservation %+v", r)
+	}
+	if want := time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC); !r.Start.Equal(want) {
+		t.Errorf("got %s, wanted %s", r.Start, want)
+	}
+	if r.Duration != 8*time.Hour {
+		t.Errorf("got %s, wanted %s", r.Duration, 8*time.Hour)
+	}
+	if r.Select == nil || *r.Select != "2:ncpus=4" {
+		t.Errorf("expected select 2:ncpus=4 but got %v", r.Select)
+	}
+	if r.Queue == nil || *r.Queue != "R12" {
+		t.Errorf("expected queue R12 but got %v", r.Queue)
+	}
+	if r.IsStanding() {
+		t.Errorf("expected R12.pbs not to be a standing reservation")
+	}
+	if got, want := r.NodeNames(), []string{"node01", "node02"}; !slices.Equal(got, want) {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+
+	s := reservations[1]
+	if s.Name != nil {
+		t.Errorf("expected no name but got %q", *s.Name)
+	}
+	if !s.IsStanding() || s.Timezone == nil || *s.Timezone != "Europe/London" {
+		t.Errorf("expected S13.pbs to be a standing reservation in Europe/London, got %+v", s)
+	}
+}
+
+func TestGenerateReservationSubmitCommand(t *testing.T) {
+	start := time.Date(2026, 11, 2, 10, 0, 0, 0, time.FixedZone("CET", 3600))
+	sel := "2:ncpus=4"
+	name := "training"
+	users := "alice,bob"
+	rrule := "FREQ=WEEKLY;COUNT=4"
+	tz := "Europe/Paris"
+
+	got := generateReservationSubmitCommand(PbsReservation{
+		Start:           start,
+		End:             start.Add(8 * time.Hour),
+		Select:          &sel,
+		Name:            &name,
+		AuthorizedUsers: &users,
+	})
+	want := `TZ=UTC /opt/pbs/bin/pbs_rsub -R 202611020900.00 -E 202611021700.00 -l 'select=2:ncpus=4' -N 'training' -U 'alice,bob'`
+	if got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+
+	got = generateReservationSubmitCommand(PbsReservation{
+		Start:    start,
+		Duration: time.Hour,
+		Select:   &sel,
+		Rrule:    &rrule,
+		Timezone: &tz,
+	})
+	want = `TZ=UTC PBS_TZID='Europe/Paris' /opt/pbs/bin/pbs_rsub -R 202611020900.00 -D 3600 -l 'select=2:ncpus=4' -r 'FREQ=WEEKLY;COUNT=4'`
+	if got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+
+	got = generateReservationSubmitCommand(PbsReservation{
+		Start:    start,
+		Duration: time.Hour,
+		Hosts:    []string{"node01", "node02"},
+	})
+	want = `TZ=UTC /opt/pbs/bin/pbs_rsub -R 202611020900.00 -D 3600 --hosts 'node01' 'node02'`
+	if got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func TestGenerateReservationAlterCommand(t *testing.T) {
+	start := time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC)
+	sel := "2:ncpus=4"
+	current := PbsReservation{ID: "R12.pbs", Start: start, Duration: time.Hour, Select: &sel}
+
+	if got := generateReservationAlterCommand(current, current); got != "" {
+		t.Errorf("expected no command but got %q", got)
+	}
+
+	desired := current
+	desired.Start = start.Add(time.Hour)
+	desired.Duration = 2 * time.Hour
+	want := `TZ=UTC /opt/pbs/bin/pbs_ralter -R 202611021000.00 -D 7200 'R12.pbs'`
+	if got := generateReservationAlterCommand(current, desired); got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+
+	desired = current
+	desired.End = start.Add(3 * time.Hour)
+	want = `TZ=UTC /opt/pbs/bin/pbs_ralter -E 202611021200.00 'R12.pbs'`
+	if got := generateReservationAlterCommand(current, desired); got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
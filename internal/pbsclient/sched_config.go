@@ -0,0 +1,224 @@
+package pbsclient
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const DefaultSchedConfigPath = "/var/spool/pbs/sched_priv/sched_config"
+
+// ErrSchedulerNotRunning is returned when a scheduler file has been written but pbs_sched wasn't running to be
+// told about it. The file is read when pbs_sched next starts so callers will usually only warn about it.
+var ErrSchedulerNotRunning = errors.New("pbs_sched is not running, the change takes effect when it is started")
+
+var schedConfigLineRegex = regexp.MustCompile(`^\s*([A-Za-z_][A-Za-z0-9_]*)\s*:\s*(.*?)\s*$`)
+
+// SchedConfigValue is the value of one line in sched_config along with the prime time period it applies to, one
+// of prime, non_prime, all or none. Prime is empty for options which don't take a period such as resources.
+type SchedConfigValue struct {
+	Value string
+	Prime string
+}
+
+// SchedConfig is a parsed sched_config file. Comments, blank lines and the layout of lines that aren't changed are
+// kept exactly as they were so that rendering the file only changes the options that were set.
+type SchedConfig struct {
+	lines configLines[SchedConfigValue]
+}
+
+// ParseSchedConfig parses the contents of a sched_config file.
+func ParseSchedConfig(content string) SchedConfig {
+	return SchedConfig{lines: parseConfigLines(content, parseSchedConfigLine)}
+}
+
+// parseSchedConfigLine returns the option set by a line of sched_config, which is false for comments.
+func parseSchedConfigLine(raw string) (string, SchedConfigValue, bool) {
+	if strings.HasPrefix(strings.TrimSpace(raw), "#") {
+		return "", SchedConfigValue{}, false
+	}
+	m := schedConfigLineRegex.FindStringSubmatch(raw)
+	if m == nil {
+		return "", SchedConfigValue{}, false
+	}
+
+	return m[1], ParseSchedConfigValue(m[2]), true
+}
+
+// ParseSchedConfigValue splits the text after the colon into the value and the prime time period. A quoted value
+// such as "ncpus, mem, host" can contain spaces and is returned without the quotes.
+func ParseSchedConfigValue(text string) SchedConfigValue {
+	if strings.HasPrefix(text, `"`) {
+		if end := strings.Index(text[1:], `"`); end >= 0 {
+			return SchedConfigValue{
+				Value: text[1 : end+1],
+				Prime: strings.TrimSpace(text[end+2:]),
+			}
+		}
+	}
+
+	fields := strings.Fields(text)
+	if len(fields) > 1 && isSchedConfigPrime(fields[len(fields)-1]) {
+		return SchedConfigValue{Value: strings.Join(fields[:len(fields)-1], " "), Prime: fields[len(fields)-1]}
+	}
+
+	return SchedConfigValue{Value: strings.Join(fields, " ")}
+}
+
+// isSchedConfigPrime reports whether value is one of the prime time periods an option can apply to.
+func isSchedConfigPrime(value string) bool {
+	switch strings.ToLower(value) {
+	case "prime", "non_prime", "all", "none":
+		return true
+	}
+	return false
+}
+
+// formatSchedConfigLine renders an option the way the default sched_config writes it, quoting values which contain
+// spaces or commas.
+func formatSchedConfigLine(key string, value SchedConfigValue) string {
+	text := value.Value
+	if strings.ContainsAny(text, " ,\t") {
+		text = `"` + text + `"`
+	}
+
+	line := key + ": " + text
+	if value.Prime != "" {
+		line += "\t" + value.Prime
+	}
+
+	return line
+}
+
+// Values returns every value set for the option in the order they appear in the file.
+func (c SchedConfig) Values(key string) []SchedConfigValue {
+	return c.lines.values(key)
+}
+
+// Keys returns every option set in the file, in the order they first appear.
+func (c SchedConfig) Keys() []string {
+	return c.lines.keys()
+}
+
+// Set replaces every line for the option with the given values. The new lines take the place of the first existing
+// line so that the option stays next to its comments, an option that isn't in the file yet is appended to it.
+// Setting no values removes the option.
+func (c *SchedConfig) Set(key string, values []SchedConfigValue) {
+	c.lines.set(key, values, formatSchedConfigLine)
+}
+
+// Resources returns the resources on the resources line, which the scheduler checks when placing jobs. It returns
+// nil if there is no resources line, in which case the scheduler checks every resource.
+func (c SchedConfig) Resources() []string {
+	values := c.Values("resources")
+	if len(values) == 0 {
+		return nil
+	}
+
+	names := []string{}
+	for _, v := range values {
+		for _, name := range strings.Split(v.Value, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+
+	return names
+}
+
+// SetResourceSchedulable adds the resource to or removes it from the resources line, leaving the other resources in
+// the order they were. Nothing is changed if there is no resources line as every resource is already checked.
+func (c *SchedConfig) SetResourceSchedulable(name string, schedulable bool) {
+	names := c.Resources()
+	if names == nil || slices.Contains(names, name) == schedulable {
+		return
+	}
+
+	if schedulable {
+		names = append(names, name)
+	} else {
+		names = slices.DeleteFunc(names, func(n string) bool { return n == name })
+	}
+
+	c.Set("resources", []SchedConfigValue{{Value: strings.Join(names, ", ")}})
+}
+
+// String renders the file.
+func (c SchedConfig) String() string {
+	return c.lines.String()
+}
+
+// GetSchedConfig reads the scheduler configuration file at path.
+func (c *PbsClient) GetSchedConfig(path string) (SchedConfig, error) {
+	out, errOutput, err := c.runCommand("cat " + escapeStringForShell(path))
+	if err != nil {
+		return SchedConfig{}, fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	return ParseSchedConfig(string(out)), nil
+}
+
+// generateWriteFileCommand returns a command which replaces the file at path with content. The file is written
+// next to the original and moved over it so that a reader never sees it half written, keeping the original's
+// owner and permissions. The file must already exist as there is nothing to take them from otherwise.
+func generateWriteFileCommand(path string, content string) string {
+	tmp := escapeStringForShell(path + ".tfnew")
+	return fmt.Sprintf("if [ ! -f %s ]; then echo %s >&2; exit 1; fi; printf '%%s' %s > %s && chmod --reference=%s %s && chown --reference=%s %s && mv -f %s %s",
+		escapeStringForShell(path), escapeStringForShell(path+" does not exist, it must be created before it can be managed"),
+		escapeStringForShell(content), tmp,
+		escapeStringForShell(path), tmp,
+		escapeStringForShell(path), tmp,
+		tmp, escapeStringForShell(path),
+	)
+}
+
+// EditSchedConfig reads the scheduler configuration file at path, applies edit to it and, if that changed anything,
+// writes it back and reloads the scheduler. Edits are made one at a time so that resources changing the file in
+// parallel don't overwrite each other. The edited configuration is returned.
+func (c *PbsClient) EditSchedConfig(path string, edit func(*SchedConfig)) (SchedConfig, error) {
+	c.schedConfigMutex.Lock()
+	defer c.schedConfigMutex.Unlock()
+
+	config, err := c.GetSchedConfig(path)
+	if err != nil {
+		return config, err
+	}
+
+	original := config.String()
+	edit(&config)
+	if config.String() == original {
+		return config, nil
+	}
+
+	return config, c.UpdateSchedConfig(path, config)
+}
+
+// UpdateSchedConfig writes the scheduler configuration file at path and sends pbs_sched a SIGHUP so that it
+// re-reads it.
+func (c *PbsClient) UpdateSchedConfig(path string, config SchedConfig) error {
+	return c.writeSchedulerFile(path, config.String())
+}
+
+// writeSchedulerFile replaces a file in sched_priv and sends pbs_sched a SIGHUP so that it re-reads its
+// configuration. ErrSchedulerNotRunning is returned if the file was written but pbs_sched isn't running.
+func (c *PbsClient) writeSchedulerFile(path string, content string) error {
+	output, errOutput, err := c.runCommands([]string{
+		generateWriteFileCommand(path, content),
+		generateHupCommand("pbs_sched"),
+	})
+	if err != nil {
+		completeErrOutput := ""
+		for _, e := range errOutput {
+			completeErrOutput += string(e)
+		}
+		return fmt.Errorf("%s %s", err, completeErrOutput)
+	}
+	if !hupMatchedProcess(output[1]) {
+		return ErrSchedulerNotRunning
+	}
+
+	return nil
+}
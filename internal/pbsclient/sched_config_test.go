@@ -0,0 +1,111 @@
+package pbsclient
+
+import (
+	"testing"
+)
+
+const testSchedConfig = `# This is the config file for the scheduling policy
+#
+#	round_robin
+#		Run a job from each queue before running second job from the
+#		first queue.
+round_robin: False	all
+
+by_queue: True		prime
+by_queue: True		non_prime
+
+strict_ordering: false	ALL
+
+resources: "ncpus, mem, arch, host, vnode, aoe, eoe"
+
+fairshare_usage_res: cput
+
+peer_queue: "workq workq@remote"
+`
+
+func TestParseSchedConfig(t *testing.T) {
+	config := ParseSchedConfig(testSchedConfig)
+
+	if got := config.String(); got != testSchedConfig {
+		t.Errorf("rendering an unchanged file changed it, got %q", got)
+	}
+
+	byQueue := config.Values("by_queue")
+	if len(byQueue) != 2 || byQueue[0] != (SchedConfigValue{Value: "True", Prime: "prime"}) || byQueue[1] != (SchedConfigValue{Value: "True", Prime: "non_prime"}) {
+		t.Errorf("unexpected by_queue values %+v", byQueue)
+	}
+	if got, want := config.Values("resources"), []SchedConfigValue{{Value: "ncpus, mem, arch, host, vnode, aoe, eoe"}}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("got %+v, wanted %+v", got, want)
+	}
+	if got, want := config.Values("peer_queue"), []SchedConfigValue{{Value: "workq workq@remote"}}; len(got) != 1 || got[0] != want[0] {
+		t.Errorf("got %+v, wanted %+v", got, want)
+	}
+	if got := config.Values("round_robin"); len(got) != 1 || got[0].Prime != "all" {
+		t.Errorf("unexpected round_robin values %+v", got)
+	}
+	if got := config.Values("Run"); len(got) != 0 {
+		t.Errorf("expected comments to be ignored but got %+v", got)
+	}
+}
+
+func TestSchedConfigSet(t *testing.T) {
+	config := ParseSchedConfig(testSchedConfig)
+
+	config.Set("by_queue", []SchedConfigValue{{Value: "false", Prime: "ALL"}})
+	config.Set("resources", []SchedConfigValue{{Value: "ncpus, mem, host, vnode, ngpus"}})
+	config.Set("peer_queue", nil)
+	config.Set("backfill_prime", []SchedConfigValue{{Value: "false", Prime: "ALL"}})
+
+	want := `# This is the config file for the scheduling policy
+#
+#	round_robin
+#		Run a job from each queue before running second job from the
+#		first queue.
+round_robin: False	all
+
+by_queue: false	ALL
+
+strict_ordering: false	ALL
+
+resources: "ncpus, mem, host, vnode, ngpus"
+
+fairshare_usage_res: cput
+
+backfill_prime: false	ALL
+`
+	if got := config.String(); got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func TestSchedConfigSetResourceSchedulable(t *testing.T) {
+	config := ParseSchedConfig(testSchedConfig)
+
+	config.SetResourceSchedulable("ngpus", true)
+	config.SetResourceSchedulable("ngpus", true)
+	if got, want := config.Values("resources")[0].Value, "ncpus, mem, arch, host, vnode, aoe, eoe, ngpus"; got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+
+	config.SetResourceSchedulable("mem", false)
+	if got, want := config.Values("resources")[0].Value, "ncpus, arch, host, vnode, aoe, eoe, ngpus"; got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+
+	// Every resource is checked when there is no resources line so nothing is added
+	config = ParseSchedConfig("round_robin: False\tall\n")
+	config.SetResourceSchedulable("ngpus", true)
+	if got := config.Resources(); got != nil {
+		t.Errorf("got %q, wanted no resources line", got)
+	}
+}
+
+func TestGenerateWriteFileCommand(t *testing.T) {
+	got := generateWriteFileCommand("/var/spool/pbs/sched_priv/sched_config", "by_queue: true\tALL\n# it's\n")
+	want := `if [ ! -f '/var/spool/pbs/sched_priv/sched_config' ]; then echo '/var/spool/pbs/sched_priv/sched_config does not exist, it must be created before it can be managed' >&2; exit 1; fi; printf '%s' 'by_queue: true	ALL
+# it'\''s
+' > '/var/spool/pbs/sched_priv/sched_config.tfnew' && chmod --reference='/var/spool/pbs/sched_priv/sched_config' '/var/spool/pbs/sched_priv/sched_config.tfnew' && chown --reference='/var/spool/pbs/sched_priv/sched_config' '/var/spool/pbs/sched_priv/sched_config.tfnew' && mv -f '/var/spool/pbs/sched_priv/sched_config.tfnew' '/var/spool/pbs/sched_priv/sched_config'`
+	if got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
@@ -89,6 +89,15 @@ type PbsServer struct {
 	WebapiEnable                  *bool
 	WebapiOidcClientid            *string
 	WebapiOidcProviderUrl         *string
+
+	// Runtime status reported by the server, these can't be set.
+	LicenseCount      map[string]int
+	PbsVersion        *string
+	ResourcesAssigned map[string]string
+	ServerHost        *string
+	ServerState       *string
+	StateCount        map[string]int
+	TotalJobs         *int32
 }
 
 // serverFieldDefinition represents a server field with its attribute name and execution order.
@@ -514,6 +523,23 @@ func parseServerOutput(output []byte) ([]PbsServer, error) {
 						current.WebapiOidcClientid = &s
 					case "webapi_oidc_provider_url":
 						current.WebapiOidcProviderUrl = &s
+					case "license_count":
+						current.LicenseCount = parseStateCount(s)
+					case "pbs_version":
+						current.PbsVersion = &s
+					case "server_host":
+						current.ServerHost = &s
+					case "server_state":
+						current.ServerState = &s
+					case "state_count":
+						current.StateCount = parseStateCount(s)
+					case "total_jobs":
+						intValue, err := strconv.Atoi(s)
+						if err != nil {
+							return nil, fmt.Errorf("failed to convert total_jobs value to int32 %s", err.Error())
+						}
+						int32Value := int32(intValue)
+						current.TotalJobs = &int32Value
 					}
 				} else if a, ok := v.(map[string]string); ok {
 					switch strings.ToLower(k) {
@@ -525,6 +551,8 @@ func parseServerOutput(output []byte) ([]PbsServer, error) {
 						current.ResourcesDefault = a
 					case "resources_max":
 						current.ResourcesMax = a
+					case "resources_assigned":
+						current.ResourcesAssigned = a
 					}
 				}
 
@@ -659,3 +687,126 @@ func (c *PbsClient) DeletePbsServer(name string) error {
 
 	return nil
 }
+
+// getServerListAttribute returns a pointer to the list valued server attribute with the given qmgr name.
+func getServerListAttribute(server PbsServer, attribute string) (*string, error) {
+	switch attribute {
+	case "managers":
+		return server.Managers, nil
+	case "operators":
+		return server.Operators, nil
+	case "acl_hosts":
+		return server.AclHosts, nil
+	case "acl_resv_groups":
+		return server.AclResvGroups, nil
+	case "acl_resv_hosts":
+		return server.AclResvHosts, nil
+	case "acl_resv_users":
+		return server.AclResvUsers, nil
+	case "acl_roots":
+		return server.AclRoots, nil
+	case "acl_users":
+		return server.AclUsers, nil
+	default:
+		return nil, fmt.Errorf("unsupported server list attribute %s", attribute)
+	}
+}
+
+// getDefaultPbsServer returns the server that the client is connected to.
+func (c *PbsClient) getDefaultPbsServer() (PbsServer, error) {
+	all, err := c.GetPbsServers()
+	if err != nil {
+		return PbsServer{}, err
+	}
+	if len(all) == 0 {
+		return PbsServer{}, fmt.Errorf("no server returned by qmgr")
+	}
+
+	return all[0], nil
+}
+
+// GetServerListEntries returns the individual entries of a list valued server attribute such as managers, operators
+// or one of the ACLs.
+func (c *PbsClient) GetServerListEntries(attribute string) ([]string, error) {
+	server, err := c.getDefaultPbsServer()
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := getServerListAttribute(server, attribute)
+	if err != nil {
+		return nil, err
+	}
+
+	return splitListAttribute(value), nil
+}
+
+// AddServerListEntry adds a single entry to a list valued server attribute using the += operator, leaving
+// any other entries untouched. Adding an entry which is already present is a no-op.
+func (c *PbsClient) AddServerListEntry(attribute string, entry string) error {
+	server, err := c.getDefaultPbsServer()
+	if err != nil {
+		return err
+	}
+
+	value, err := getServerListAttribute(server, attribute)
+	if err != nil {
+		return err
+	}
+	if _, ok := FindListEntry(splitListAttribute(value), entry); ok {
+		return nil
+	}
+
+	_, errOutput, err := c.runCommand(generateListEntryCommand("server", server.Name, attribute, "+=", entry))
+	if err != nil {
+		return fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	return nil
+}
+
+// RemoveServerListEntry removes a single entry from a list valued server attribute using the -= operator,
+// leaving any other entries untouched. Removing an entry which isn't present is a no-op.
+func (c *PbsClient) RemoveServerListEntry(attribute string, entry string) error {
+	server, err := c.getDefaultPbsServer()
+	if err != nil {
+		return err
+	}
+
+	value, err := getServerListAttribute(server, attribute)
+	if err != nil {
+		return err
+	}
+	stored, ok := FindListEntry(splitListAttribute(value), entry)
+	if !ok {
+		return nil
+	}
+
+	_, errOutput, err := c.runCommand(generateListEntryCommand("server", server.Name, attribute, "-=", stored))
+	if err != nil {
+		return fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	return nil
+}
+
+// GetServerAttribute returns the value of a single server attribute exactly as reported by qmgr. The resource
+// sub-key is optional and is used for attributes such as resources_available. A nil value means the attribute
+// is not set.
+func (c *PbsClient) GetServerAttribute(attribute string, resource *string) (*string, error) {
+	value, found, err := c.getQmgrObjectAttribute("server", "", attribute, resource)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("no server returned by qmgr")
+	}
+
+	return value, nil
+}
+
+// UpdateServerAttribute sets a single server attribute without touching any other attribute. Passing a nil
+// value unsets the attribute. Nothing is run if the attribute already has the requested value.
+func (c *PbsClient) UpdateServerAttribute(attribute string, resource *string, value *string) error {
+	return c.updateQmgrObjectAttribute("server", "", attribute, resource, value)
+}
@@ -41,3 +41,46 @@ func TestPbsServerParsing(t *testing.T) {
 		t.Errorf("got %q, wanted %q", *parsedOutput[0].Operators, "admin@*,one.another@*,another.chap@*")
 	}
 }
+
+func TestPbsServerRuntimeStatusParsing(t *testing.T) {
+	sourceText := `Server pbs
+    server_state = Active
+    server_host = pbs.example.com
+    total_jobs = 5
+    state_count = Transit:0 Queued:3 Held:0 Waiting:0 Running:2 Exiting:0 Begun:0
+    resources_assigned.ncpus = 8
+    resources_assigned.nodect = 2
+    pbs_version = 2022.1.1
+    license_count = Avail_Global:1000000 Avail_Local:1000000 Used:8 High_Use:16`
+
+	parsedOutput, err := parseServerOutput([]byte(sourceText))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(parsedOutput) != 1 {
+		t.Fatalf("expected 1 output from parsing result but got %d", len(parsedOutput))
+	}
+
+	s := parsedOutput[0]
+	if s.ServerState == nil || *s.ServerState != "Active" {
+		t.Errorf("got server_state %v, wanted %q", s.ServerState, "Active")
+	}
+	if s.ServerHost == nil || *s.ServerHost != "pbs.example.com" {
+		t.Errorf("got server_host %v, wanted %q", s.ServerHost, "pbs.example.com")
+	}
+	if s.PbsVersion == nil || *s.PbsVersion != "2022.1.1" {
+		t.Errorf("got pbs_version %v, wanted %q", s.PbsVersion, "2022.1.1")
+	}
+	if s.TotalJobs == nil || *s.TotalJobs != 5 {
+		t.Errorf("got total_jobs %v, wanted 5", s.TotalJobs)
+	}
+	if s.StateCount["Queued"] != 3 || s.StateCount["Running"] != 2 {
+		t.Errorf("got state_count %v", s.StateCount)
+	}
+	if s.LicenseCount["Used"] != 8 || s.LicenseCount["Avail_Global"] != 1000000 {
+		t.Errorf("got license_count %v", s.LicenseCount)
+	}
+	if s.ResourcesAssigned["ncpus"] != "8" {
+		t.Errorf("got resources_assigned %v", s.ResourcesAssigned)
+	}
+}
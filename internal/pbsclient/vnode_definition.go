@@ -0,0 +1,214 @@
+package pbsclient
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const pbsMomCommand = "/opt/pbs/sbin/pbs_mom"
+
+// vnodeDefinitionStagingSuffix is added to the name of a configuration file while it is being replaced.
+const vnodeDefinitionStagingSuffix = ".tfnew"
+
+// VnodeSharingValues are the values the sharing attribute of a vnode can take.
+var VnodeSharingValues = []string{"default_shared", "default_excl", "default_exclhost", "ignore_excl", "force_excl", "force_exclhost"}
+
+// VnodeDefinition is one vnode in a version 2 MoM configuration file.
+type VnodeDefinition struct {
+	Name               string
+	Sharing            *string
+	Priority           *int32
+	ResourcesAvailable map[string]string
+}
+
+// FormatVnodeDefinitions renders a version 2 configuration file, with one "<vnode>: <attribute> = <value>" line per
+// attribute.
+func FormatVnodeDefinitions(vnodes []VnodeDefinition) string {
+	var b strings.Builder
+	b.WriteString("$configversion 2\n")
+	for _, v := range vnodes {
+		if v.Sharing != nil {
+			fmt.Fprintf(&b, "%s: sharing = %s\n", v.Name, *v.Sharing)
+		}
+		if v.Priority != nil {
+			fmt.Fprintf(&b, "%s: priority = %d\n", v.Name, *v.Priority)
+		}
+
+		resources := make([]string, 0, len(v.ResourcesAvailable))
+		for r := range v.ResourcesAvailable {
+			resources = append(resources, r)
+		}
+		sort.Strings(resources)
+		for _, r := range resources {
+			fmt.Fprintf(&b, "%s: resources_available.%s = %s\n", v.Name, r, v.ResourcesAvailable[r])
+		}
+	}
+
+	return b.String()
+}
+
+// ParseVnodeDefinitions parses a version 2 configuration file. Vnodes are returned in the order they first appear,
+// attributes other than sharing, priority and resources_available are ignored.
+func ParseVnodeDefinitions(content string) ([]VnodeDefinition, error) {
+	vnodes := []VnodeDefinition{}
+	index := map[string]int{}
+
+	for i, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "$configversion") {
+			continue
+		}
+
+		name, assignment, ok := strings.Cut(trimmed, ":")
+		if !ok {
+			return nil, fmt.Errorf("line %d of the vnode definition must be <vnode>: <attribute> = <value>: %q", i+1, line)
+		}
+		attribute, value, ok := strings.Cut(assignment, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d of the vnode definition must be <vnode>: <attribute> = <value>: %q", i+1, line)
+		}
+		name, attribute, value = strings.TrimSpace(name), strings.TrimSpace(attribute), strings.TrimSpace(value)
+
+		if _, ok := index[name]; !ok {
+			index[name] = len(vnodes)
+			vnodes = append(vnodes, VnodeDefinition{Name: name, ResourcesAvailable: map[string]string{}})
+		}
+		v := &vnodes[index[name]]
+
+		switch {
+		case attribute == "sharing":
+			v.Sharing = &value
+		case attribute == "priority":
+			priority, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("line %d of the vnode definition has an invalid priority: %q", i+1, line)
+			}
+			p := int32(priority)
+			v.Priority = &p
+		case strings.HasPrefix(attribute, "resources_available."):
+			v.ResourcesAvailable[strings.TrimPrefix(attribute, "resources_available.")] = value
+		}
+	}
+
+	return vnodes, nil
+}
+
+// GetVnodeDefinitionFile reads the configuration file inserted into the MoM as name, the second value is false if
+// there is no such file. The client must be connected to the MoM's host, see ForHost.
+func (c *PbsClient) GetVnodeDefinitionFile(name string) ([]VnodeDefinition, bool, error) {
+	out, errOutput, err := c.runCommand(pbsMomCommand + " -s list")
+	if err != nil {
+		return nil, false, fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	found := false
+	for _, line := range strings.Split(string(out), "\n") {
+		if strings.TrimSpace(line) == name {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, false, nil
+	}
+
+	out, errOutput, err = c.runCommand(pbsMomCommand + " -s show " + escapeStringForShell(name))
+	if err != nil {
+		return nil, false, fmt.Errorf("%s %s", err, errOutput)
+	}
+
+	vnodes, err := ParseVnodeDefinitions(string(out))
+	return vnodes, true, err
+}
+
+// CreateVnodeDefinitionFile inserts the vnodes into the MoM as a configuration file called name and sends pbs_mom a
+// SIGHUP so that it reads it. As with EditMomConfig, ErrMomNotRunning is returned if the file was inserted but
+// pbs_mom isn't running.
+func (c *PbsClient) CreateVnodeDefinitionFile(name string, vnodes []VnodeDefinition) error {
+	output, errOutput, err := c.runCommands([]string{
+		generateInsertVnodeDefinitionCommand(name, FormatVnodeDefinitions(vnodes)),
+		generateHupCommand("pbs_mom"),
+	})
+	if err != nil {
+		completeErrOutput := ""
+		for _, e := range errOutput {
+			completeErrOutput += string(e)
+		}
+		return fmt.Errorf("%s %s", err, completeErrOutput)
+	}
+	if !hupMatchedProcess(output[1]) {
+		return ErrMomNotRunning
+	}
+
+	return nil
+}
+
+// UpdateVnodeDefinitionFile replaces the configuration file called name. pbs_mom can't insert over an existing file
+// so the old one has to be removed first, the new one is inserted under a staging name beforehand so that a file
+// pbs_mom rejects is found while the old one is still in place. If inserting it under name still fails the new
+// definition is left inserted under the staging name, which the error reports. ErrMomNotRunning is returned if the
+// file was replaced but pbs_mom isn't running.
+func (c *PbsClient) UpdateVnodeDefinitionFile(name string, vnodes []VnodeDefinition) error {
+	content := FormatVnodeDefinitions(vnodes)
+	staging := escapeStringForShell(name + vnodeDefinitionStagingSuffix)
+	output, errOutput, err := c.runCommands([]string{
+		// A staging file left behind by an earlier update that failed is replaced
+		pbsMomCommand + " -s remove " + staging + " >/dev/null 2>&1; " +
+			generateInsertVnodeDefinitionCommand(name+vnodeDefinitionStagingSuffix, content),
+		pbsMomCommand + " -s remove " + escapeStringForShell(name),
+		generateInsertVnodeDefinitionCommand(name, content),
+		pbsMomCommand + " -s remove " + staging,
+		generateHupCommand("pbs_mom"),
+	})
+	if err != nil {
+		completeErrOutput := ""
+		for _, e := range errOutput {
+			completeErrOutput += string(e)
+		}
+		if len(output) == 3 {
+			return fmt.Errorf("%s %s; the previous file %s was already removed, the new definition is inserted as %s",
+				err, completeErrOutput, name, name+vnodeDefinitionStagingSuffix)
+		}
+		if len(output) == 5 {
+			return fmt.Errorf("%s %s; %s was replaced but pbs_mom couldn't be sent a SIGHUP", err, completeErrOutput, name)
+		}
+		return fmt.Errorf("%s %s", err, completeErrOutput)
+	}
+	if !hupMatchedProcess(output[4]) {
+		return ErrMomNotRunning
+	}
+
+	return nil
+}
+
+// generateInsertVnodeDefinitionCommand returns a command which inserts content into the MoM as a configuration file
+// called name. pbs_mom copies the file into mom_priv/config.d so the input is only needed until it has been
+// inserted, it is written to a file made by mktemp so that nothing can have been put in its place beforehand.
+func generateInsertVnodeDefinitionCommand(name string, content string) string {
+	return fmt.Sprintf(`f=$(mktemp) && printf '%%s' %s > "$f" && %s -s insert %s "$f"; rc=$?; rm -f "$f"; exit $rc`,
+		escapeStringForShell(content), pbsMomCommand, escapeStringForShell(name))
+}
+
+// DeleteVnodeDefinitionFile removes the configuration file called name from the MoM and sends pbs_mom a SIGHUP.
+// The vnodes it defined stay on the server until they are deleted with qmgr. ErrMomNotRunning is returned if the file
+// was removed but pbs_mom isn't running.
+func (c *PbsClient) DeleteVnodeDefinitionFile(name string) error {
+	output, errOutput, err := c.runCommands([]string{
+		pbsMomCommand + " -s remove " + escapeStringForShell(name),
+		generateHupCommand("pbs_mom"),
+	})
+	if err != nil {
+		completeErrOutput := ""
+		for _, e := range errOutput {
+			completeErrOutput += string(e)
+		}
+		return fmt.Errorf("%s %s", err, completeErrOutput)
+	}
+	if !hupMatchedProcess(output[1]) {
+		return ErrMomNotRunning
+	}
+
+	return nil
+}
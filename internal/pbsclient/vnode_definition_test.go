@@ -0,0 +1,74 @@
+package pbsclient
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatVnodeDefinitions(t *testing.T) {
+	sharing := "force_excl"
+	priority := int32(10)
+	vnodes := []VnodeDefinition{
+		{Name: "gpu01", Sharing: &sharing},
+		{Name: "gpu01[0]", Priority: &priority, ResourcesAvailable: map[string]string{"ngpus": "2", "ncpus": "16", "mem": "64gb"}},
+	}
+
+	want := `$configversion 2
+gpu01: sharing = force_excl
+gpu01[0]: priority = 10
+gpu01[0]: resources_available.mem = 64gb
+gpu01[0]: resources_available.ncpus = 16
+gpu01[0]: resources_available.ngpus = 2
+`
+	if got := FormatVnodeDefinitions(vnodes); got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func TestParseVnodeDefinitions(t *testing.T) {
+	vnodes, err := ParseVnodeDefinitions(`$configversion 2
+# socket 0
+gpu01[0]: resources_available.ncpus = 16
+gpu01[1]:resources_available.ncpus=16
+gpu01[0]: priority = 5
+gpu01[0]: pnames = socket
+gpu01: sharing = ignore_excl
+`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(vnodes) != 3 {
+		t.Fatalf("got %d vnodes, wanted 3", len(vnodes))
+	}
+	if got := vnodes[0].Name; got != "gpu01[0]" {
+		t.Errorf("got %q, wanted %q", got, "gpu01[0]")
+	}
+	if vnodes[0].Priority == nil || *vnodes[0].Priority != 5 {
+		t.Errorf("got %v, wanted priority 5", vnodes[0].Priority)
+	}
+	if got := vnodes[1].ResourcesAvailable["ncpus"]; got != "16" {
+		t.Errorf("got %q, wanted %q", got, "16")
+	}
+	if vnodes[2].Sharing == nil || *vnodes[2].Sharing != "ignore_excl" {
+		t.Errorf("got %v, wanted sharing ignore_excl", vnodes[2].Sharing)
+	}
+
+	for _, content := range []string{"gpu01 resources_available.ncpus 16", "gpu01: priority = high"} {
+		if _, err := ParseVnodeDefinitions(content); err == nil {
+			t.Errorf("wanted an error parsing %q", content)
+		}
+	}
+}
+
+func TestGenerateInsertVnodeDefinitionCommand(t *testing.T) {
+	got := generateInsertVnodeDefinitionCommand("gpu01", "$configversion 2\n")
+	want := `f=$(mktemp) && printf '%s' '$configversion 2
+' > "$f" && /opt/pbs/sbin/pbs_mom -s insert 'gpu01' "$f"; rc=$?; rm -f "$f"; exit $rc`
+	if got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+	if strings.Contains(got, "/tmp/") {
+		t.Errorf("got %q, wanted the input written to a file made by mktemp", got)
+	}
+}
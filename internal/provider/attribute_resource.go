@@ -0,0 +1,351 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"terraform-provider-pbs/internal/pbsclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &attributeResource{}
+	_ resource.ResourceWithConfigure   = &attributeResource{}
+	_ resource.ResourceWithImportState = &attributeResource{}
+)
+
+var (
+	attributeNameRegex     = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	attributeResourceRegex = regexp.MustCompile(`^[a-zA-Z0-9_\-]+$`)
+)
+
+// NewServerAttributeResource manages a single attribute on the server.
+func NewServerAttributeResource() resource.Resource {
+	return &attributeResource{
+		typeSuffix:      "_server_attribute",
+		objType:         "server",
+		idDescription:   DescServerAttributeID,
+		nameDescription: DescServerAttributeName,
+		newModel:        func() attributeTargetModel { return &serverAttributeModel{} },
+		getAttribute: func(c *pbsclient.PbsClient, _ string, attribute string, resource *string) (*string, bool, error) {
+			value, err := c.GetServerAttribute(attribute, resource)
+			return value, true, err
+		},
+		updateAttribute: func(c *pbsclient.PbsClient, _ string, attribute string, resource *string, value *string) error {
+			return c.UpdateServerAttribute(attribute, resource, value)
+		},
+	}
+}
+
+// NewQueueAttributeResource manages a single attribute on a queue.
+func NewQueueAttributeResource() resource.Resource {
+	return &attributeResource{
+		typeSuffix:        "_queue_attribute",
+		objType:           "queue",
+		parent:            "queue",
+		idDescription:     DescQueueAttributeID,
+		parentDescription: DescQueueAttributeQueue,
+		nameDescription:   DescQueueAttributeName,
+		newModel:          func() attributeTargetModel { return &queueAttributeModel{} },
+		getAttribute:      (*pbsclient.PbsClient).GetQueueAttribute,
+		updateAttribute:   (*pbsclient.PbsClient).UpdateQueueAttribute,
+	}
+}
+
+// NewNodeAttributeResource manages a single attribute on a node.
+func NewNodeAttributeResource() resource.Resource {
+	return &attributeResource{
+		typeSuffix:        "_node_attribute",
+		objType:           "node",
+		parent:            "node",
+		idDescription:     DescNodeAttributeID,
+		parentDescription: DescNodeAttributeNode,
+		nameDescription:   DescNodeAttributeName,
+		newModel:          func() attributeTargetModel { return &nodeAttributeModel{} },
+		getAttribute:      (*pbsclient.PbsClient).GetNodeAttribute,
+		updateAttribute:   (*pbsclient.PbsClient).UpdateNodeAttribute,
+	}
+}
+
+// attributeResource owns exactly one attribute (or one resource sub-key of an attribute such as
+// resources_available.ncpus) on the server, a queue or a node so that different teams can manage their own
+// settings in separate states. The server has no parent attribute, queues and nodes are named by the attribute
+// in parent.
+type attributeResource struct {
+	client            *pbsclient.PbsClient
+	typeSuffix        string
+	objType           string
+	parent            string
+	idDescription     string
+	parentDescription string
+	nameDescription   string
+	newModel          func() attributeTargetModel
+	getAttribute      func(c *pbsclient.PbsClient, parent string, attribute string, resource *string) (*string, bool, error)
+	updateAttribute   func(c *pbsclient.PbsClient, parent string, attribute string, resource *string, value *string) error
+}
+
+// attributeModel holds the attributes shared by every attribute resource.
+type attributeModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Resource types.String `tfsdk:"resource"`
+	Value    types.String `tfsdk:"value"`
+}
+
+// serverAttributeModel is the model of pbs_server_attribute, the server has no parent attribute.
+type serverAttributeModel struct {
+	attributeModel
+}
+
+// queueAttributeModel is the model of pbs_queue_attribute.
+type queueAttributeModel struct {
+	attributeModel
+	Queue types.String `tfsdk:"queue"`
+}
+
+// nodeAttributeModel is the model of pbs_node_attribute.
+type nodeAttributeModel struct {
+	attributeModel
+	Node types.String `tfsdk:"node"`
+}
+
+// attributeTargetModel is implemented by the model of each attribute resource so that the shared attributes and
+// the parent object, which is nil for the server, can be reached whatever the target.
+type attributeTargetModel interface {
+	attributes() *attributeModel
+	parent() *types.String
+}
+
+func (m *serverAttributeModel) attributes() *attributeModel { return &m.attributeModel }
+func (m *serverAttributeModel) parent() *types.String       { return nil }
+func (m *queueAttributeModel) attributes() *attributeModel  { return &m.attributeModel }
+func (m *queueAttributeModel) parent() *types.String        { return &m.Queue }
+func (m *nodeAttributeModel) attributes() *attributeModel   { return &m.attributeModel }
+func (m *nodeAttributeModel) parent() *types.String         { return &m.Node }
+
+// attributeName returns the qmgr name of the attribute, including the resource sub-key if there is one.
+func (m attributeModel) attributeName() string {
+	if m.Resource.IsNull() || m.Resource.ValueString() == "" {
+		return m.Name.ValueString()
+	}
+	return m.Name.ValueString() + "." + m.Resource.ValueString()
+}
+
+// parentName returns the name of the object the attribute is set on, empty for the server.
+func parentName(m attributeTargetModel) string {
+	if p := m.parent(); p != nil {
+		return p.ValueString()
+	}
+	return ""
+}
+
+// id returns the terraform ID, the qmgr attribute name prefixed by the parent object if there is one.
+func (r *attributeResource) id(m attributeTargetModel) string {
+	if r.parent == "" {
+		return m.attributes().attributeName()
+	}
+	return parentName(m) + "/" + m.attributes().attributeName()
+}
+
+// target describes the object the attribute is set on for error messages.
+func (r *attributeResource) target(m attributeTargetModel) string {
+	if r.parent == "" {
+		return r.objType
+	}
+	return r.objType + " " + parentName(m)
+}
+
+func (r *attributeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeSuffix
+}
+
+func (r *attributeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: r.idDescription,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: r.nameDescription,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(attributeNameRegex, "must be a valid PBS attribute name"),
+			},
+		},
+		"resource": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: DescAttributeResource,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(attributeResourceRegex, "must be a valid PBS resource name"),
+			},
+		},
+		"value": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: DescAttributeValue,
+		},
+	}
+	if r.parent != "" {
+		attributes[r.parent] = schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: r.parentDescription,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (r *attributeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pbsclient.PbsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pbsclient.PbsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *attributeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := r.newModel()
+	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model := data.attributes()
+
+	err := r.updateAttribute(r.client, parentName(data), model.Name.ValueString(), model.Resource.ValueStringPointer(), model.Value.ValueStringPointer())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating resource", fmt.Sprintf("Could not set %s on %s, unexpected error: %s", model.attributeName(), r.target(data), err))
+		return
+	}
+
+	model.ID = types.StringValue(r.id(data))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *attributeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := r.newModel()
+	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state := data.attributes()
+
+	// For import, split the ID into the parent, attribute name and optional resource
+	if state.Name.IsNull() && !state.ID.IsNull() {
+		attribute := state.ID.ValueString()
+		if parent := data.parent(); parent != nil {
+			name, rest, ok := strings.Cut(attribute, "/")
+			if !ok {
+				resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected an ID of the form <%s>/<attribute>, got: %s", r.parent, state.ID.ValueString()))
+				return
+			}
+			*parent = types.StringValue(name)
+			attribute = rest
+		}
+		name, res, found := strings.Cut(attribute, ".")
+		state.Name = types.StringValue(name)
+		if found {
+			state.Resource = types.StringValue(res)
+		}
+	}
+
+	value, found, err := r.getAttribute(r.client, parentName(data), state.Name.ValueString(), state.Resource.ValueStringPointer())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s on %s, got error: %s", state.attributeName(), r.target(data), err))
+		return
+	}
+
+	// If the object has been deleted or the attribute unset outside of terraform, remove it from the state
+	if !found || value == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// PBS reports booleans as True/False so keep the configured form when the values only differ by case
+	if !strings.EqualFold(state.Value.ValueString(), *value) {
+		state.Value = types.StringValue(*value)
+	}
+	state.ID = types.StringValue(r.id(data))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *attributeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	data := r.newModel()
+	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model := data.attributes()
+
+	err := r.updateAttribute(r.client, parentName(data), model.Name.ValueString(), model.Resource.ValueStringPointer(), model.Value.ValueStringPointer())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	model.ID = types.StringValue(r.id(data))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *attributeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := r.newModel()
+	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model := data.attributes()
+
+	err := r.updateAttribute(r.client, parentName(data), model.Name.ValueString(), model.Resource.ValueStringPointer(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unset %s on %s, got error: %s", model.attributeName(), r.target(data), err))
+		return
+	}
+}
+
+func (r *attributeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the standard passthrough for ID, which will set both id and trigger a Read
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
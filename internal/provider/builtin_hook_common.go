@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"terraform-provider-pbs/internal/pbsclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type pbsBuiltinHookModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Alarm      types.Int32  `tfsdk:"alarm"`
+	Debug      types.Bool   `tfsdk:"debug"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	Event      types.String `tfsdk:"event"`
+	FailAction types.String `tfsdk:"fail_action"`
+	Freq       types.Int32  `tfsdk:"freq"`
+	Order      types.Int32  `tfsdk:"order"`
+	Type       types.String `tfsdk:"type"`
+	User       types.String `tfsdk:"user"`
+}
+
+// ToPbsHook converts the managed attributes of the model into a PbsHook. Attributes which are not
+// configured are left nil so they are never touched on the server.
+func (m pbsBuiltinHookModel) ToPbsHook() pbsclient.PbsHook {
+	hook := pbsclient.PbsHook{
+		Name: m.Name.ValueString(),
+	}
+
+	SetInt32PointerIfNotNull(m.Alarm, &hook.Alarm)
+	SetBoolPointerIfNotNull(m.Debug, &hook.Debug)
+	SetBoolPointerIfNotNull(m.Enabled, &hook.Enabled)
+	SetStringPointerIfNotNull(m.FailAction, &hook.FailAction)
+	SetInt32PointerIfNotNull(m.Freq, &hook.Freq)
+	SetInt32PointerIfNotNull(m.Order, &hook.Order)
+
+	return hook
+}
+
+// createPbsBuiltinHookModel builds the model for a built-in hook. Only the attributes which are
+// already managed in managed are populated so that the defaults PBS reports for the remaining
+// attributes don't show up as drift. When managed is nil (i.e. on import) every attribute is populated.
+func createPbsBuiltinHookModel(h pbsclient.PbsHook, managed *pbsBuiltinHookModel) pbsBuiltinHookModel {
+	model := pbsBuiltinHookModel{
+		ID:    types.StringValue(h.Name), // Use name as ID
+		Name:  types.StringValue(h.Name),
+		Event: types.StringPointerValue(h.Event),
+		Type:  types.StringPointerValue(h.Type),
+		User:  types.StringPointerValue(h.User),
+	}
+
+	if managed == nil || !managed.Alarm.IsNull() {
+		model.Alarm = types.Int32PointerValue(h.Alarm)
+	}
+	if managed == nil || !managed.Debug.IsNull() {
+		model.Debug = types.BoolPointerValue(h.Debug)
+	}
+	if managed == nil || !managed.Enabled.IsNull() {
+		model.Enabled = types.BoolPointerValue(h.Enabled)
+	}
+	if managed == nil || !managed.FailAction.IsNull() {
+		model.FailAction = types.StringPointerValue(h.FailAction)
+	}
+	if managed == nil || !managed.Freq.IsNull() {
+		model.Freq = types.Int32PointerValue(h.Freq)
+	}
+	if managed == nil || !managed.Order.IsNull() {
+		model.Order = types.Int32PointerValue(h.Order)
+	}
+
+	return model
+}
@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-pbs/internal/pbsclient"
+	validators "terraform-provider-pbs/internal/provider/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ resource.Resource                = &pbsBuiltinHookResource{}
+	_ resource.ResourceWithConfigure   = &pbsBuiltinHookResource{}
+	_ resource.ResourceWithImportState = &pbsBuiltinHookResource{}
+)
+
+func NewPbsBuiltinHookResource() resource.Resource {
+	return &pbsBuiltinHookResource{}
+}
+
+type pbsBuiltinHookResource struct {
+	client *pbsclient.PbsClient
+}
+
+func (r *pbsBuiltinHookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_builtin_hook"
+}
+
+func (r *pbsBuiltinHookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescBuiltinHookID,
+			},
+			"alarm": schema.Int32Attribute{
+				MarkdownDescription: DescHookAlarm,
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"debug": schema.BoolAttribute{
+				MarkdownDescription: DescHookDebug,
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: DescHookEnabled,
+				Optional:            true,
+			},
+			"event": schema.StringAttribute{
+				MarkdownDescription: DescHookEvent,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fail_action": schema.StringAttribute{
+				MarkdownDescription: DescHookFailAction,
+				Optional:            true,
+				Validators: []validator.String{
+					validators.PbsString(),
+				},
+			},
+			"freq": schema.Int32Attribute{
+				MarkdownDescription: DescHookFreq,
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: DescBuiltinHookName,
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.PbsString(),
+				},
+			},
+			"order": schema.Int32Attribute{
+				MarkdownDescription: DescHookOrder,
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.Between(-1000, 2000),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: DescBuiltinHookType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: DescHookUser,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *pbsBuiltinHookResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pbsclient.PbsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pbsclient.PbsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *pbsBuiltinHookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model pbsBuiltinHookModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Built-in hooks can't be created so "creating" this resource means taking ownership of an existing hook
+	existing, err := r.client.GetBuiltinHook(model.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read built-in hook, got error: %s", err))
+		return
+	}
+	if existing.Name == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Built-in Hook Not Found",
+			fmt.Sprintf("No built-in hook named %q exists on the PBS server. Built-in hooks are shipped with PBS and cannot be created, use pbs_hook for site hooks.", model.Name.ValueString()),
+		)
+		return
+	}
+
+	pbsHook, err := r.client.UpdateBuiltinHook(pbsclient.PbsHook{Name: existing.Name}, model.ToPbsHook())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating resource", "Could not configure built-in hook, unexpected error: "+err.Error())
+		return
+	}
+
+	model = createPbsBuiltinHookModel(pbsHook, &model)
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *pbsBuiltinHookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state pbsBuiltinHookModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// For import, use ID if name is not set and populate every attribute
+	managed := &state
+	hookName := state.Name.ValueString()
+	if hookName == "" && !state.ID.IsNull() {
+		hookName = state.ID.ValueString()
+		managed = nil
+	}
+
+	pbsHook, err := r.client.GetBuiltinHook(hookName)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read built-in hook, got error: %s", err))
+		return
+	}
+
+	// If the hook is not found, remove it from the state
+	if pbsHook.Name == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	updatedState := createPbsBuiltinHookModel(pbsHook, managed)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedState)...)
+}
+
+func (r *pbsBuiltinHookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state pbsBuiltinHookModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedHook, err := r.client.UpdateBuiltinHook(state.ToPbsHook(), plan.ToPbsHook())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	updatedModel := createPbsBuiltinHookModel(updatedHook, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedModel)...)
+}
+
+func (r *pbsBuiltinHookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data pbsBuiltinHookModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Built-in hooks can't be deleted, instead every managed attribute is unset to restore the PBS default
+	err := r.client.ResetBuiltinHook(data.ToPbsHook())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restore built-in hook defaults, got error: %s", err))
+		return
+	}
+}
+
+func (r *pbsBuiltinHookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the standard passthrough for ID, which will set both id and trigger a Read
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
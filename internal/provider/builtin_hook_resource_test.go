@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccBuiltinHookResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			// Take ownership of a built-in hook and tune it
+			{
+				Config: testAccBuiltinHookResourceConfig("PBS_power", 200, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBuiltinHookExists("pbs_builtin_hook.test"),
+					resource.TestCheckResourceAttr("pbs_builtin_hook.test", "name", "PBS_power"),
+					resource.TestCheckResourceAttr("pbs_builtin_hook.test", "type", "pbs"),
+					resource.TestCheckResourceAttr("pbs_builtin_hook.test", "freq", "200"),
+					resource.TestCheckResourceAttr("pbs_builtin_hook.test", "enabled", "false"),
+					resource.TestCheckNoResourceAttr("pbs_builtin_hook.test", "alarm"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccBuiltinHookResourceConfig("PBS_power", 400, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBuiltinHookExists("pbs_builtin_hook.test"),
+					resource.TestCheckResourceAttr("pbs_builtin_hook.test", "freq", "400"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBuiltinHookResource_notFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBuiltinHookResourceConfig("not_a_pbs_hook", 200, false),
+				ExpectError: regexp.MustCompile(`Built-in Hook Not Found`),
+			},
+		},
+	})
+}
+
+func testAccCheckBuiltinHookExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Built-in Hook ID is set")
+		}
+
+		return nil
+	}
+}
+
+func testAccBuiltinHookResourceConfig(name string, freq int, enabled bool) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_builtin_hook" "test" {
+  name    = %[1]q
+  freq    = %[2]d
+  enabled = %[3]t
+}
+`, name, freq, enabled)
+}
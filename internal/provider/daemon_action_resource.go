@@ -0,0 +1,289 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-pbs/internal/pbsclient"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultDaemonActionTimeout = 5 * time.Minute
+	daemonActionPollInterval   = 2 * time.Second
+)
+
+var (
+	_ resource.Resource              = &daemonActionResource{}
+	_ resource.ResourceWithConfigure = &daemonActionResource{}
+)
+
+func NewDaemonActionResource() resource.Resource {
+	return &daemonActionResource{}
+}
+
+// daemonActionResource reloads or restarts a PBS daemon when it is created, and again whenever triggers change. Like
+// terraform_data it has nothing to read back, the state records the outcome of the last run.
+type daemonActionResource struct {
+	client *pbsclient.PbsClient
+}
+
+type daemonActionModel struct {
+	ID          types.String            `tfsdk:"id"`
+	Triggers    map[string]types.String `tfsdk:"triggers"`
+	Daemon      types.String            `tfsdk:"daemon"`
+	Action      types.String            `tfsdk:"action"`
+	Host        types.String            `tfsdk:"host"`
+	Connection  *sshConnectionModel     `tfsdk:"connection"`
+	Timeout     types.Int32             `tfsdk:"timeout"`
+	PreviousPid types.Int64             `tfsdk:"previous_pid"`
+	Pid         types.Int64             `tfsdk:"pid"`
+	CompletedAt types.String            `tfsdk:"completed_at"`
+}
+
+func (r *daemonActionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_daemon_action"
+}
+
+func (r *daemonActionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescDaemonActionID,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:            true,
+				MarkdownDescription: DescDaemonActionTriggers,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"daemon": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: DescDaemonActionDaemon,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(pbsclient.PbsDaemons...),
+				},
+			},
+			"action": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: DescDaemonActionAction,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(pbsclient.DaemonActionHup, pbsclient.DaemonActionRestart),
+				},
+			},
+			"host": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: DescDaemonActionHost,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"connection": sshConnectionSchema(),
+			"timeout": schema.Int32Attribute{
+				Optional:            true,
+				MarkdownDescription: DescDaemonActionTimeout,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"previous_pid": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: DescDaemonActionPreviousPid,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"pid": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: DescDaemonActionPid,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"completed_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescDaemonActionCompletedAt,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *daemonActionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pbsclient.PbsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pbsclient.PbsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *daemonActionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model daemonActionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.run(ctx, &model)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating resource", fmt.Sprintf("Could not %s %s, unexpected error: %s", model.Action.ValueString(), model.Daemon.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *daemonActionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// The action has already happened so there is nothing to refresh
+}
+
+func (r *daemonActionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan daemonActionModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the connection or timeout can change without replacing the resource, neither reruns the action
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *daemonActionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Nothing to undo, the resource is only removed from state
+}
+
+// run carries out the action and waits for the daemon to be healthy, recording the outcome in model.
+func (r *daemonActionResource) run(ctx context.Context, model *daemonActionModel) error {
+	client, err := sshHostClient(r.client, model.Host.ValueString(), model.Connection)
+	if err != nil {
+		return err
+	}
+
+	daemon := model.Daemon.ValueString()
+	timeout := defaultDaemonActionTimeout
+	if !model.Timeout.IsNull() {
+		timeout = time.Duration(model.Timeout.ValueInt32()) * time.Second
+	}
+	deadline := time.Now().Add(timeout)
+
+	previous, err := client.GetDaemonPid(daemon)
+	if err != nil {
+		return err
+	}
+
+	switch model.Action.ValueString() {
+	case pbsclient.DaemonActionHup:
+		if previous == 0 {
+			return fmt.Errorf("%s is not running", daemon)
+		}
+		err = client.HupDaemon(daemon)
+		if err != nil {
+			return err
+		}
+	case pbsclient.DaemonActionRestart:
+		// A daemon that isn't running is just started
+		if previous != 0 {
+			err = client.StopDaemon(daemon)
+			if err != nil {
+				return err
+			}
+
+			_, err = waitForDaemon(ctx, deadline, daemon, "Waiting for daemon to stop", func() (int, error) {
+				pid, err := client.GetDaemonPid(daemon)
+				if err == nil && pid == previous {
+					err = fmt.Errorf("%s is still running as PID %d", daemon, pid)
+				}
+				return pid, err
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		err = client.StartDaemon(daemon)
+		if err != nil {
+			return err
+		}
+	}
+
+	pid, err := waitForDaemon(ctx, deadline, daemon, "Waiting for daemon to become healthy", func() (int, error) {
+		return client.CheckDaemonHealthy(daemon)
+	})
+	if err != nil {
+		return err
+	}
+
+	host := model.Host.ValueString()
+	if host == "" {
+		host = "server"
+	}
+	model.ID = types.StringValue(daemon + "@" + host)
+	model.PreviousPid = types.Int64Value(int64(previous))
+	model.Pid = types.Int64Value(int64(pid))
+	model.CompletedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	return nil
+}
+
+// waitForDaemon polls check until it succeeds or the deadline passes, returning the PID from the last check. The
+// error from the last check is returned if it never succeeded.
+func waitForDaemon(ctx context.Context, deadline time.Time, daemon string, message string, check func() (int, error)) (int, error) {
+	for {
+		pid, err := check()
+		if err == nil {
+			return pid, nil
+		}
+		if !time.Now().Before(deadline) {
+			return pid, fmt.Errorf("timed out: %s", err)
+		}
+
+		tflog.Info(ctx, message, map[string]any{
+			"daemon":    daemon,
+			"status":    err.Error(),
+			"remaining": time.Until(deadline).Round(time.Second).String(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return pid, ctx.Err()
+		case <-time.After(daemonActionPollInterval):
+		}
+	}
+}
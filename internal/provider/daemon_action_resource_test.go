@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestWaitForDaemon(t *testing.T) {
+	checks := 0
+	pid, err := waitForDaemon(context.Background(), time.Now().Add(time.Minute), "pbs_sched", "Waiting", func() (int, error) {
+		checks++
+		return 1234, nil
+	})
+	if err != nil || pid != 1234 || checks != 1 {
+		t.Errorf("got pid %d, error %v after %d checks, wanted pid 1234 after 1 check", pid, err, checks)
+	}
+
+	// The last check's error is returned once the deadline has passed
+	_, err = waitForDaemon(context.Background(), time.Now(), "pbs_sched", "Waiting", func() (int, error) {
+		return 0, errors.New("pbs_sched is not running")
+	})
+	if err == nil || err.Error() != "timed out: pbs_sched is not running" {
+		t.Errorf("got %v, wanted a timeout error", err)
+	}
+}
+
+func TestAccDaemonActionResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDaemonActionResourceConfig("hup", "one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_daemon_action.test", "id", "pbs_sched@server"),
+					resource.TestCheckResourceAttrPair("pbs_daemon_action.test", "pid", "pbs_daemon_action.test", "previous_pid"),
+				),
+			},
+			{
+				Config: testAccDaemonActionResourceConfig("restart", "two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("pbs_daemon_action.test", "pid"),
+					resource.TestCheckResourceAttrSet("pbs_daemon_action.test", "completed_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDaemonActionResourceConfig(action string, trigger string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_daemon_action" "test" {
+  daemon = "pbs_sched"
+  action = %[1]q
+
+  triggers = {
+    test = %[2]q
+  }
+}
+`, action, trigger)
+}
@@ -19,6 +19,9 @@ func TestAccQueueDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.pbs_queue.test", "queue_type", "Execution"),
 					resource.TestCheckResourceAttrSet("data.pbs_queue.test", "enabled"),
 					resource.TestCheckResourceAttrSet("data.pbs_queue.test", "started"),
+					resource.TestCheckResourceAttrSet("data.pbs_queue.test", "total_jobs"),
+					resource.TestCheckResourceAttrSet("data.pbs_queue.test", "state_count.Queued"),
+					resource.TestCheckResourceAttrSet("data.pbs_queue.test", "state_count.Running"),
 				),
 			},
 		},
@@ -35,6 +38,12 @@ func TestAccServerDataSource_basic(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("data.pbs_server.test", "name", "pbs"),
 					resource.TestCheckResourceAttrSet("data.pbs_server.test", "log_events"),
+					resource.TestCheckResourceAttrSet("data.pbs_server.test", "server_state"),
+					resource.TestCheckResourceAttrSet("data.pbs_server.test", "server_host"),
+					resource.TestCheckResourceAttrSet("data.pbs_server.test", "pbs_version"),
+					resource.TestCheckResourceAttrSet("data.pbs_server.test", "total_jobs"),
+					resource.TestCheckResourceAttrSet("data.pbs_server.test", "state_count.Queued"),
+					resource.TestCheckResourceAttrSet("data.pbs_server.test", "license_count.Used"),
 				),
 			},
 		},
@@ -57,6 +66,62 @@ func TestAccNodeDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccNodesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNodesDataSourceConfig("^pbs$"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pbs_nodes.test", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.pbs_nodes.test", "names.0", "pbs"),
+					resource.TestCheckResourceAttr("data.pbs_nodes.test", "nodes.0.name", "pbs"),
+					resource.TestCheckResourceAttrSet("data.pbs_nodes.test", "nodes.0.state"),
+				),
+			},
+			{
+				Config: testAccNodesDataSourceConfig("^does-not-exist$"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pbs_nodes.test", "names.#", "0"),
+					resource.TestCheckResourceAttr("data.pbs_nodes.test", "nodes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJobsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig() + `
+data "pbs_jobs" "test" {
+  queue = "workq"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pbs_jobs.test", "id", "jobs"),
+					resource.TestCheckResourceAttrSet("data.pbs_jobs.test", "ids.#"),
+				),
+			},
+			{
+				Config: providerConfig() + `
+data "pbs_jobs" "test" {
+  job_ids = ["999999.pbs"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pbs_jobs.test", "ids.#", "0"),
+					resource.TestCheckResourceAttr("data.pbs_jobs.test", "jobs.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccPbsResourceDataSource_basic(t *testing.T) {
 	// First create a resource to query
 	resourceName := testAccResourceName("test_data_resource")
@@ -99,6 +164,76 @@ func TestAccHookDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccQueuesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueuesDataSourceConfig("Execution"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.pbs_queues.test", "names.*", "workq"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.pbs_queues.test", "queues.*", map[string]string{
+						"name":       "workq",
+						"queue_type": "Execution",
+					}),
+				),
+			},
+			{
+				Config: testAccQueuesDataSourceConfig("Route"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pbs_queues.test", "id", "queues"),
+					resource.TestCheckResourceAttrSet("data.pbs_queues.test", "names.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHooksDataSource_basic(t *testing.T) {
+	hookName := testAccResourceName("test_data_hooks")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHooksDataSourceConfig(hookName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.pbs_hooks.test", "names.*", hookName),
+					resource.TestCheckTypeSetElemNestedAttrs("data.pbs_hooks.test", "hooks.*", map[string]string{
+						"name":    hookName,
+						"enabled": "true",
+						"alarm":   "30",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPbsResourcesDataSource_basic(t *testing.T) {
+	resourceName := testAccResourceName("test_data_resources")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPbsResourcesDataSourceConfig(resourceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.pbs_resources.test", "names.*", resourceName),
+					resource.TestCheckTypeSetElemNestedAttrs("data.pbs_resources.test", "resources.*", map[string]string{
+						"name": resourceName,
+						"type": "size",
+						"flag": "h",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccQueueDataSourceConfig(name string) string {
 	return providerConfig() + fmt.Sprintf(`
 data "pbs_queue" "test" {
@@ -158,3 +293,77 @@ data "pbs_hook" "test" {
 }
 `, name)
 }
+
+func testAccNodesDataSourceConfig(nameRegex string) string {
+	return providerConfig() + fmt.Sprintf(`
+data "pbs_nodes" "test" {
+  name_regex = %[1]q
+}
+`, nameRegex)
+}
+
+func testAccQueuesDataSourceConfig(queueType string) string {
+	return providerConfig() + fmt.Sprintf(`
+data "pbs_queues" "test" {
+  queue_type = %[1]q
+}
+`, queueType)
+}
+
+func testAccHooksDataSourceConfig(name string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_hook" "test" {
+  name        = %[1]q
+  enabled     = true
+  event       = "execjob_begin,execjob_end"
+  order       = 1
+  type        = "site"
+  user        = "pbsadmin"
+  fail_action = "none"
+  alarm       = 30
+  debug       = false
+}
+
+data "pbs_hooks" "test" {
+  type    = "site"
+  enabled = true
+  event   = "execjob_end"
+
+  depends_on = [pbs_hook.test]
+}
+`, name)
+}
+
+func testAccPbsResourcesDataSourceConfig(name string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_resource" "test" {
+  name = %[1]q
+  type = "size"
+  flag = "h"
+}
+
+data "pbs_resources" "test" {
+  type = "size"
+
+  depends_on = [pbs_resource.test]
+}
+`, name)
+}
+
+func TestAccFairshareUsageDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig() + `
+data "pbs_fairshare_usage" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pbs_fairshare_usage.test", "id", "fairshare_usage"),
+					resource.TestCheckResourceAttr("data.pbs_fairshare_usage.test", "entities.0.name", "root"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"terraform-provider-pbs/internal/pbsclient"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &dedicatedTimeResource{}
+	_ resource.ResourceWithConfigure      = &dedicatedTimeResource{}
+	_ resource.ResourceWithImportState    = &dedicatedTimeResource{}
+	_ resource.ResourceWithValidateConfig = &dedicatedTimeResource{}
+)
+
+var dedicatedTimeRegex = regexp.MustCompile(`^[0-9]{4}-[0-9]{2}-[0-9]{2}T[0-9]{2}:[0-9]{2}$`)
+
+func NewDedicatedTimeResource() resource.Resource {
+	return &dedicatedTimeResource{}
+}
+
+// dedicatedTimeResource owns the scheduler's dedicated_time file. During dedicated time only jobs in queues whose
+// names start with ded are run.
+type dedicatedTimeResource struct {
+	client *pbsclient.PbsClient
+}
+
+type dedicatedTimeModel struct {
+	ID      types.String               `tfsdk:"id"`
+	Path    types.String               `tfsdk:"path"`
+	Windows []dedicatedTimeWindowModel `tfsdk:"windows"`
+}
+
+type dedicatedTimeWindowModel struct {
+	Start types.String `tfsdk:"start"`
+	End   types.String `tfsdk:"end"`
+}
+
+func (m dedicatedTimeModel) ToDedicatedTime() ([]pbsclient.DedicatedTime, error) {
+	windows := make([]pbsclient.DedicatedTime, 0, len(m.Windows))
+	for _, w := range m.Windows {
+		start, err := time.Parse(pbsclient.DedicatedTimeFormat, w.Start.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("start %q must be in the form YYYY-MM-DDTHH:MM", w.Start.ValueString())
+		}
+		end, err := time.Parse(pbsclient.DedicatedTimeFormat, w.End.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("end %q must be in the form YYYY-MM-DDTHH:MM", w.End.ValueString())
+		}
+		windows = append(windows, pbsclient.DedicatedTime{Start: start, End: end})
+	}
+
+	return windows, nil
+}
+
+func createDedicatedTimeModel(filePath types.String, windows []pbsclient.DedicatedTime) dedicatedTimeModel {
+	model := dedicatedTimeModel{
+		ID:      filePath,
+		Path:    filePath,
+		Windows: []dedicatedTimeWindowModel{},
+	}
+
+	for _, w := range windows {
+		model.Windows = append(model.Windows, dedicatedTimeWindowModel{
+			Start: types.StringValue(w.Start.Format(pbsclient.DedicatedTimeFormat)),
+			End:   types.StringValue(w.End.Format(pbsclient.DedicatedTimeFormat)),
+		})
+	}
+
+	return model
+}
+
+func (r *dedicatedTimeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dedicated_time"
+}
+
+func (r *dedicatedTimeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescDedicatedTimeID,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(pbsclient.DefaultDedicatedTimePath),
+				MarkdownDescription: DescDedicatedTimePath,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(absolutePathRegex, "must be an absolute path"),
+				},
+			},
+			"windows": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: DescDedicatedTimeWindows,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: DescDedicatedTimeStart,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(dedicatedTimeRegex, "must be a time as YYYY-MM-DDTHH:MM"),
+							},
+						},
+						"end": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: DescDedicatedTimeEnd,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(dedicatedTimeRegex, "must be a time as YYYY-MM-DDTHH:MM"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *dedicatedTimeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pbsclient.PbsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pbsclient.PbsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dedicatedTimeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// The windows can only be checked once they are fully known
+	var windows types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("windows"), &windows)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if raw, err := windows.ToTerraformValue(ctx); err != nil || !raw.IsFullyKnown() {
+		return
+	}
+
+	var data dedicatedTimeModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parsed, err := data.ToDedicatedTime()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("windows"), "Invalid Dedicated Time", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(validateDedicatedTime(parsed)...)
+}
+
+func (r *dedicatedTimeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model dedicatedTimeModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	windows, err := model.ToDedicatedTime()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Dedicated Time", err.Error())
+		return
+	}
+
+	err = warnIfSchedulerNotRunning(&resp.Diagnostics, r.client.UpdateDedicatedTime(model.Path.ValueString(), windows))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating resource", fmt.Sprintf("Could not write %s, unexpected error: %s", model.Path.ValueString(), err))
+		return
+	}
+
+	model.ID = model.Path
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *dedicatedTimeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dedicatedTimeModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// For import, the ID is the path of the file
+	if state.Path.IsNull() {
+		state.Path = state.ID
+	}
+
+	windows, err := r.client.GetDedicatedTime(state.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s, got error: %s", state.Path.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createDedicatedTimeModel(state.Path, windows))...)
+}
+
+func (r *dedicatedTimeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dedicatedTimeModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	windows, err := plan.ToDedicatedTime()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Dedicated Time", err.Error())
+		return
+	}
+
+	err = warnIfSchedulerNotRunning(&resp.Diagnostics, r.client.UpdateDedicatedTime(plan.Path.ValueString(), windows))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the dedicated time file. "+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.Path
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *dedicatedTimeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data dedicatedTimeModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An empty file means there is no dedicated time
+	err := warnIfSchedulerNotRunning(&resp.Diagnostics, r.client.UpdateDedicatedTime(data.Path.ValueString(), nil))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to empty %s, got error: %s", data.Path.ValueString(), err))
+		return
+	}
+}
+
+func (r *dedicatedTimeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the standard passthrough for ID, which will set both id and trigger a Read
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// validateDedicatedTime checks that every window ends after it starts and that no two windows overlap.
+func validateDedicatedTime(windows []pbsclient.DedicatedTime) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, w := range windows {
+		if !w.End.After(w.Start) {
+			diags.AddAttributeError(path.Root("windows"), "Invalid Dedicated Time", fmt.Sprintf("The window starting %s must end after it starts.", w.Start.Format(pbsclient.DedicatedTimeFormat)))
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	sorted := append([]pbsclient.DedicatedTime{}, windows...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start.Before(sorted[j].Start) })
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].Start.Before(sorted[i-1].End) {
+			diags.AddAttributeError(path.Root("windows"), "Overlapping Dedicated Time", fmt.Sprintf("The windows starting %s and %s overlap.", sorted[i-1].Start.Format(pbsclient.DedicatedTimeFormat), sorted[i].Start.Format(pbsclient.DedicatedTimeFormat)))
+		}
+	}
+
+	return diags
+}
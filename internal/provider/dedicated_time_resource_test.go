@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-pbs/internal/pbsclient"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestValidateDedicatedTime(t *testing.T) {
+	at := func(day, hour int) time.Time { return time.Date(2026, 11, day, hour, 0, 0, 0, time.UTC) }
+	tests := []struct {
+		name    string
+		windows []pbsclient.DedicatedTime
+		want    string
+	}{
+		{"valid", []pbsclient.DedicatedTime{{Start: at(3, 8), End: at(3, 17)}, {Start: at(2, 8), End: at(2, 17)}}, ""},
+		{"backwards", []pbsclient.DedicatedTime{{Start: at(2, 17), End: at(2, 8)}}, "Invalid Dedicated Time"},
+		{"overlapping", []pbsclient.DedicatedTime{{Start: at(2, 8), End: at(2, 17)}, {Start: at(2, 12), End: at(2, 20)}}, "Overlapping Dedicated Time"},
+	}
+
+	for _, tt := range tests {
+		diags := validateDedicatedTime(tt.windows)
+		if tt.want == "" {
+			if diags.HasError() {
+				t.Errorf("%s: unexpected errors %v", tt.name, diags)
+			}
+			continue
+		}
+		if !diags.HasError() || diags.Errors()[0].Summary() != tt.want {
+			t.Errorf("%s: got %v, wanted %q", tt.name, diags, tt.want)
+		}
+	}
+}
+
+func TestAccDedicatedTimeResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDedicatedTimeResourceConfig("2030-11-02T17:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_dedicated_time.test", "id", pbsclient.DefaultDedicatedTimePath),
+					resource.TestCheckResourceAttr("pbs_dedicated_time.test", "windows.#", "1"),
+					resource.TestCheckResourceAttr("pbs_dedicated_time.test", "windows.0.end", "2030-11-02T17:00"),
+				),
+			},
+			{
+				ResourceName:      "pbs_dedicated_time.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDedicatedTimeResourceConfig("2030-11-02T18:30"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_dedicated_time.test", "windows.0.end", "2030-11-02T18:30"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDedicatedTimeResource_invalidWindow(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDedicatedTimeResourceConfig("2030-11-02T07:00"),
+				ExpectError: regexp.MustCompile(`must end after it starts`),
+			},
+		},
+	})
+}
+
+func testAccDedicatedTimeResourceConfig(end string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_dedicated_time" "test" {
+  windows = [
+    { start = "2030-11-02T08:00", end = %[1]q },
+  ]
+}
+`, end)
+}
@@ -18,26 +18,285 @@ const (
 	DescHookUser       = "Specifies who executes the hook."
 )
 
+// Built-in hook docs.
+const (
+	DescBuiltinHookID   = "The unique identifier for this built-in hook. This is the same as the name."
+	DescBuiltinHookName = "The name of an existing built-in (type=pbs) hook, for example `PBS_power` or `PBS_alps_inventory_check`. Built-in hooks cannot be created or deleted so the hook must already exist on the server."
+	DescBuiltinHookType = "The type of the hook. This is always `pbs` for a built-in hook."
+)
+
+// Server manager/operator membership docs.
+const (
+	DescServerManagerID    = "The unique identifier for this manager entry. This is the same as the user."
+	DescServerManagerUser  = "A single entry in the server `managers` list in the form `user@host`. The host may contain wildcards, e.g. `alice@*`. Other entries in the list are left untouched."
+	DescServerOperatorID   = "The unique identifier for this operator entry. This is the same as the user."
+	DescServerOperatorUser = "A single entry in the server `operators` list in the form `user@host`. The host may contain wildcards, e.g. `alice@*`. Other entries in the list are left untouched."
+)
+
+// ACL entry docs.
+const (
+	DescQueueAclEntryID    = "The unique identifier for this ACL entry in the form `<queue>/<acl>/<entry>`."
+	DescQueueAclEntryQueue = "The name of the queue whose ACL this entry belongs to. The queue may be managed elsewhere."
+	DescQueueAclEntryAcl   = "The queue ACL attribute to add the entry to, one of `acl_users`, `acl_groups` or `acl_hosts`."
+	DescServerAclEntryID   = "The unique identifier for this ACL entry in the form `<acl>/<entry>`."
+	DescServerAclEntryAcl  = "The server ACL attribute to add the entry to, one of `acl_hosts`, `acl_roots`, `acl_users`, `acl_resv_groups`, `acl_resv_hosts` or `acl_resv_users`."
+	DescAclEntryEntry      = "A single ACL entry, e.g. `alice@*`, `+staff` or `-*.untrusted.example.com`. A leading `+` grants access (the default when no sign is given) and a leading `-` denies it. Other entries in the ACL are left untouched."
+)
+
+// Single attribute docs.
+const (
+	DescServerAttributeID   = "The unique identifier for this attribute, e.g. `scheduler_iteration` or `resources_available.ncpus`."
+	DescServerAttributeName = "The name of the server attribute to manage, e.g. `scheduler_iteration` or `resources_available`. Any other server attributes are left untouched."
+	DescQueueAttributeID    = "The unique identifier for this attribute in the form `<queue>/<attribute>`, e.g. `gpu/resources_max.ngpus`."
+	DescQueueAttributeQueue = "The name of the queue to set the attribute on. The queue may be managed elsewhere."
+	DescQueueAttributeName  = "The name of the queue attribute to manage, e.g. `priority` or `resources_max`. Any other queue attributes are left untouched."
+	DescNodeAttributeID     = "The unique identifier for this attribute in the form `<node>/<attribute>`, e.g. `node01/resources_available.ngpus`."
+	DescNodeAttributeNode   = "The name of the node to set the attribute on. The node may be managed elsewhere."
+	DescNodeAttributeName   = "The name of the node attribute to manage, e.g. `priority` or `resources_available`. Any other node attributes are left untouched."
+	DescAttributeResource   = "The resource sub-key for resource valued attributes, e.g. `ncpus` for `resources_available.ncpus`."
+	DescAttributeValue      = "The value of the attribute as it would be passed to qmgr. Values are compared with the qmgr output so use the form PBS reports, e.g. `01:00:00` rather than `3600` for a duration. Booleans are compared case insensitively."
+	DescIgnoreAttributes    = "Attributes that are managed elsewhere, for example by `pbs_server_attribute`, `pbs_queue_attribute` or `pbs_node_attribute`, and should be left untouched by this resource. Entries are either an attribute name such as `priority` or a single resource of a map attribute such as `resources_available.ngpus`."
+)
+
+// Function docs.
+const (
+	DescFunctionExpandHostlist    = "Expands a hostlist expression such as `gpu[01-16,20]` into the individual hostnames, in order. Several comma separated terms may be given and a term may contain several bracket groups, e.g. `rack[1-2]n[01-16],login01`. Numbers are zero padded to the width of the lower bound of their range."
+	DescFunctionHostlistParameter = "The hostlist expression to expand."
+	DescFunctionCompactHostlist   = "Compacts a list of hostnames into a hostlist expression, the inverse of `expand_hostlist`. Hostnames are deduplicated and sorted and consecutive numbers are merged into ranges, e.g. `[\"gpu01\", \"gpu02\", \"gpu03\", \"gpu20\"]` gives `gpu[01-03,20]`."
+	DescFunctionHostsParameter    = "The hostnames to compact. Hostnames must not contain brackets or commas."
+)
+
 // Node docs.
 const (
-	DescNodeID                 = "The unique identifier for this node. This is the same as the name."
-	DescNodeComment            = "Information about this vnode. This attribute may be set by the manager to any string to inform users of any information relating to the node. If this attribute is not explicitly set, the PBS server will use the attribute to pass information about the node status, specifically why the node is down. If the attribute is explicitly set by the manager, it will not be modified by the server."
-	DescNodeCurrentAoe         = "The AOE currently instantiated on this vnode. Case-sensitive. Cannot be set on server's host."
-	DescNodeCurrentEoe         = "Current value of eoe on this vnode. We do not recommend setting this attribute manually."
-	DescNodeInMultiNodeHost    = "Specifies whether a vnode is part of a multi-vnoded host. Used internally. Do not set."
-	DescNodeMom                = "Hostname where server queries for MoM host. By default the server queries the canonicalized name of the MoM host, unless you set this attribute when you create the vnode. Can be explicitly set by Manager only via qmgr, and only at vnode creation. The server can set this to the FQDN of the host on which MoM runs, if the vnode name is the same as the hostname."
-	DescNodeName               = "The name of this vnode. Must be resolvable to an IP address. Must be unique within the server."
-	DescNodeNoMultinodeJobs    = "Controls whether jobs which request more than one chunk are allowed to execute on this vnode. Used for cycle harvesting."
-	DescNodePartition          = "Name of partition to which this vnode is assigned. A vnode can be assigned to at most one partition."
-	DescNodePNames             = "The list of resources being used for placement sets. Not used for scheduling; advisory only."
-	DescNodePort               = "Port number on which MoM daemon listens. Can be explicitly set only via qmgr, and only at vnode creation."
-	DescNodePoweroffEligible   = "Enables powering this vnode up and down by PBS."
-	DescNodePowerProvisioning  = "Specifies whether this node is eligible to have its power managed by PBS, including whether it can use power profiles."
-	DescNodePriority           = "The priority of this vnode compared with other vnodes."
-	DescNodeProvisionEnable    = "Controls whether this vnode can be provisioned. Cannot be set on server's host."
-	DescNodeQueue              = "Deprecated. The queue with which this vnode is associated. Each vnode can be associated with at most 1 queue. Queues can be associated with multiple vnodes. Any jobs in a queue that has associated vnodes can run only on those vnodes. If a vnode has an associated queue, only jobs in that queue can run on that vnode."
-	DescNodeResourcesAvailable = "The list of resources and the amounts available on this vnode. If not explicitly set, the amount shown is that reported by the pbs_mom running on this vnode. If a resource value is explicitly set, that value is retained across restarts."
-	DescNodeResvEnable         = "Controls whether the vnode can be used for advance and standing reservations. Reservations are incompatible with cycle harvesting."
+	DescNodeOffline               = "Whether the node should be marked offline, e.g. for maintenance. Nodes are taken offline with `pbsnodes -o` and returned to service with `pbsnodes -r`. Running jobs are left to finish but no new jobs are started on an offline node. Leave unset to not manage the offline state."
+	DescNodeDrainOnDestroy        = "Drain the node before deleting it. The node is marked offline and deletion waits until no jobs are running on it or `drain_timeout` elapses. Defaults to `false`, in which case the node is deleted immediately."
+	DescNodeDrainTimeout          = "The number of seconds to wait for running jobs to finish when `drain_on_destroy` is set. Defaults to 3600."
+	DescNodeRequeueOnDrainTimeout = "Requeue jobs that are still running when `drain_timeout` elapses with `qrerun` and then delete the node. When `false` (the default) the destroy fails and the node is left offline."
+	DescNodeOfflineComment        = "The reason recorded in the node comment (`pbsnodes -C`) when the node is marked offline. Conflicts with `comment` as both set the same node attribute."
+	DescNodeID                    = "The unique identifier for this node. This is the same as the name."
+	DescNodeComment               = "Information about this vnode. This attribute may be set by the manager to any string to inform users of any information relating to the node. If this attribute is not explicitly set, the PBS server will use the attribute to pass information about the node status, specifically why the node is down. If the attribute is explicitly set by the manager, it will not be modified by the server."
+	DescNodeCurrentAoe            = "The AOE currently instantiated on this vnode. Case-sensitive. Cannot be set on server's host."
+	DescNodeCurrentEoe            = "Current value of eoe on this vnode. We do not recommend setting this attribute manually."
+	DescNodeInMultiNodeHost       = "Specifies whether a vnode is part of a multi-vnoded host. Used internally. Do not set."
+	DescNodeMom                   = "Hostname where server queries for MoM host. By default the server queries the canonicalized name of the MoM host, unless you set this attribute when you create the vnode. Can be explicitly set by Manager only via qmgr, and only at vnode creation. The server can set this to the FQDN of the host on which MoM runs, if the vnode name is the same as the hostname."
+	DescNodeName                  = "The name of this vnode. Must be resolvable to an IP address. Must be unique within the server."
+	DescNodeNoMultinodeJobs       = "Controls whether jobs which request more than one chunk are allowed to execute on this vnode. Used for cycle harvesting."
+	DescNodePartition             = "Name of partition to which this vnode is assigned. A vnode can be assigned to at most one partition."
+	DescNodePNames                = "The list of resources being used for placement sets. Not used for scheduling; advisory only."
+	DescNodePort                  = "Port number on which MoM daemon listens. Can be explicitly set only via qmgr, and only at vnode creation."
+	DescNodePoweroffEligible      = "Enables powering this vnode up and down by PBS."
+	DescNodePowerProvisioning     = "Specifies whether this node is eligible to have its power managed by PBS, including whether it can use power profiles."
+	DescNodePriority              = "The priority of this vnode compared with other vnodes."
+	DescNodeProvisionEnable       = "Controls whether this vnode can be provisioned. Cannot be set on server's host."
+	DescNodeQueue                 = "Deprecated. The queue with which this vnode is associated. Each vnode can be associated with at most 1 queue. Queues can be associated with multiple vnodes. Any jobs in a queue that has associated vnodes can run only on those vnodes. If a vnode has an associated queue, only jobs in that queue can run on that vnode."
+	DescNodeResourcesAvailable    = "The list of resources and the amounts available on this vnode. If not explicitly set, the amount shown is that reported by the pbs_mom running on this vnode. If a resource value is explicitly set, that value is retained across restarts."
+	DescNodeResvEnable            = "Controls whether the vnode can be used for advance and standing reservations. Reservations are incompatible with cycle harvesting."
+)
+
+// Node pool docs.
+const (
+	DescNodePoolID                 = "The unique identifier for this node pool. This is the same as the name."
+	DescNodePoolName               = "A name for the pool. It is only used to identify the pool in terraform, PBS has no notion of a pool."
+	DescNodePoolHosts              = "The hostnames of the nodes in the pool. Each entry is either a single hostname or a hostlist pattern with numeric ranges in brackets, e.g. `cn[001-512]` or `gpu[01-04,08]`. Every node must be resolvable by the PBS server."
+	DescNodePoolQueue              = "The queue every node in the pool is associated with. Deprecated by PBS in favour of partitions."
+	DescNodePoolPartition          = "The partition every node in the pool is assigned to."
+	DescNodePoolPriority           = "The priority of every node in the pool compared with other vnodes."
+	DescNodePoolResourcesAvailable = "Resources set explicitly on every node in the pool, e.g. `ngpus`. Resources which are not listed keep the values reported by the MoM."
+	DescNodePoolOverrides          = "Per-node overrides keyed by hostname. `queue`, `partition` and `priority` replace the shared value and `resources_available` is merged over the shared resources. Every key must be a node in the pool."
+	DescNodePoolNodes              = "The hostnames of the nodes in the pool. After a refresh only nodes which exist and match the pool configuration are listed so that missing or drifted nodes show up as a change."
+)
+
+// Nodes data source docs.
+const (
+	DescNodesID                 = "A fixed identifier for this data source."
+	DescNodesState              = "Only return nodes in this state, e.g. `free`, `offline`, `down` or `job-busy`. A node matches if this is any one of its states."
+	DescNodesQueue              = "Only return nodes associated with this queue."
+	DescNodesPartition          = "Only return nodes assigned to this partition."
+	DescNodesNameRegex          = "Only return nodes whose name matches this regular expression, e.g. `^gpu`. Uses Go regular expression syntax."
+	DescNodesResourcesAvailable = "Only return nodes with every one of these `resources_available` values, e.g. `{ ngpus = \"4\" }`. Values are compared case insensitively."
+	DescNodesNames              = "The names of the matching nodes, sorted by name."
+	DescNodesNodes              = "The matching nodes, sorted by name."
+	DescNodesNodeState          = "The current state of the node as reported by the server, e.g. `free` or `job-busy,offline`."
+	DescNodesNodeJobs           = "The IDs of the jobs running on the node."
+)
+
+// Jobs data source docs.
+const (
+	DescJobsID                = "A fixed identifier for this data source."
+	DescJobsQueue             = "Only return jobs in this queue."
+	DescJobsUser              = "Only return jobs owned by this user, without the submission host, e.g. `alice`."
+	DescJobsState             = "Only return jobs in this state, one of the single letter states reported by `qstat` such as `Q` (queued), `R` (running) or `H` (held)."
+	DescJobsJobIDs            = "Only return these jobs, e.g. `[\"12.pbs\"]`. Jobs which don't exist are left out rather than causing an error."
+	DescJobsIDs               = "The IDs of the matching jobs, sorted by sequence number."
+	DescJobsJobs              = "The matching jobs, sorted by sequence number."
+	DescJobID                 = "The ID of the job, e.g. `12.pbs`."
+	DescJobName               = "The name of the job."
+	DescJobOwner              = "The owner of the job including the submission host, e.g. `alice@login01`."
+	DescJobQueue              = "The queue the job is in."
+	DescJobState              = "The single letter state of the job, e.g. `Q` or `R`."
+	DescJobResourcesRequested = "The resources requested by the job (its `Resource_List`), e.g. `ncpus`, `mem`, `select` and `walltime`."
+	DescJobResourcesUsed      = "The resources used so far by the job, only set once the job has started."
+	DescJobExecHost           = "The hosts and CPUs the job is running on, e.g. `node01/0*4`. Only set once the job has started."
+)
+
+// Queues data source docs.
+const (
+	DescQueuesID        = "A fixed identifier for this data source."
+	DescQueuesQueueType = "Only return queues of this type, either `Execution` or `Route`."
+	DescQueuesEnabled   = "Only return queues which are (or are not) enabled, i.e. accepting new jobs."
+	DescQueuesStarted   = "Only return queues which are (or are not) started, i.e. running or routing jobs."
+	DescQueuesNames     = "The names of the matching queues, sorted by name."
+	DescQueuesQueues    = "The matching queues, sorted by name."
+)
+
+// Hooks data source docs.
+const (
+	DescHooksID      = "A fixed identifier for this data source."
+	DescHooksType    = "Only return hooks of this type, either `site` or `pbs`."
+	DescHooksEnabled = "Only return hooks which are (or are not) enabled."
+	DescHooksEvent   = "Only return hooks triggered by this event, e.g. `queuejob`. A hook matches if this is any one of its events."
+	DescHooksNames   = "The names of the matching hooks, sorted by name."
+	DescHooksHooks   = "The matching hooks, sorted by name."
+)
+
+// Resources data source docs.
+const (
+	DescPbsResourcesID        = "A fixed identifier for this data source."
+	DescPbsResourcesType      = "Only return resources of this data type, one of boolean, string, long, size, float, string_array."
+	DescPbsResourcesNames     = "The names of the matching resources, sorted by name."
+	DescPbsResourcesResources = "The matching resources, sorted by name."
+)
+
+// Reservation docs.
+const (
+	DescReservationID         = "The ID of the reservation assigned by the server, e.g. `R12.pbs` or `S13.pbs` for a standing reservation."
+	DescReservationName       = "A name for the reservation, shown by `pbs_rstat`. PBS can't clear the name of an existing reservation so removing it from the configuration keeps the current name."
+	DescReservationStart      = "When the reservation starts, as an RFC 3339 timestamp, e.g. `2026-11-02T09:00:00Z`. For a standing reservation this is the start of the first occurrence."
+	DescReservationEnd        = "When the reservation ends, as an RFC 3339 timestamp. Exactly one of `end` and `duration` must be set, the other is calculated by the server."
+	DescReservationDuration   = "How long the reservation lasts, in the form `[[hh:]mm:]ss`, e.g. `08:00:00`. Exactly one of `end` and `duration` must be set, the other is calculated by the server."
+	DescReservationSelect     = "The resources to reserve as a select statement, e.g. `2:ncpus=4:mem=16gb`."
+	DescReservationRecurrence = "An iCalendar recurrence rule which makes this a standing reservation, e.g. `FREQ=WEEKLY;BYDAY=MO;COUNT=4`. Must be set along with `timezone`. Changing this forces a new reservation."
+	DescReservationTimezone   = "The timezone the occurrences of a standing reservation are calculated in, e.g. `Europe/London`. Must be set along with `recurrence`. Changing this forces a new reservation."
+	DescReservationUsers      = "The users allowed to submit jobs to the reservation, e.g. `[\"alice\", \"bob@*\"]`. Defaults to the owner of the reservation."
+	DescReservationQueue      = "The name of the queue created for the reservation, submit jobs to it with `qsub -q`."
+	DescReservationState      = "The state of the reservation, e.g. `RESV_CONFIRMED` or `RESV_RUNNING`."
+)
+
+// Maintenance reservation docs.
+const (
+	DescMaintenanceReservationID    = "The ID of the maintenance reservation assigned by the server, e.g. `M12.pbs`."
+	DescMaintenanceReservationHosts = "The hosts to reserve. Each entry is a host name or a hostlist expression such as `node[01-16]`. Changing this forces a new reservation."
+	DescMaintenanceReservationStart = "When the maintenance window starts, as an RFC 3339 timestamp, e.g. `2026-11-02T06:00:00Z`. Changing this forces a new reservation."
+	DescMaintenanceReservationNodes = "The vnodes assigned to the reservation as reported by the server."
+)
+
+// Scheduler config docs.
+const (
+	DescSchedConfigID                = "The path of the file, used as the ID."
+	DescSchedConfigPath              = "The path of the scheduler configuration file. Defaults to `/var/spool/pbs/sched_priv/sched_config`. The file must already exist. Changing this forces a new resource."
+	DescSchedConfigResources         = "The resources the scheduler checks when placing jobs, written as the `resources` option, e.g. `[\"ncpus\", \"mem\", \"host\", \"vnode\"]`."
+	DescSchedConfigFairshareUsageRes = "The expression used to calculate fairshare usage, written as the `fairshare_usage_res` option, e.g. `cput`."
+	DescSchedConfigBackfillPrime     = "Whether the scheduler backfills around prime time boundaries, written as the `backfill_prime` option for `ALL` time."
+	DescSchedConfigStrictOrdering    = "Whether jobs are run in strict priority order, written as the `strict_ordering` option for `ALL` time."
+	DescSchedConfigByQueue           = "Whether the scheduler considers jobs queue by queue, written as the `by_queue` option for `ALL` time."
+	DescSchedConfigPeerQueues        = "Peer scheduling queue mappings, each written as its own `peer_queue` line, e.g. `\"workq workq@otherserver\"`."
+	DescSchedConfigOptions           = "Any other options, keyed by option name. A value may end with the prime time period it applies to, e.g. `\"true ALL\"`. Options with their own attribute can't be set here."
+)
+
+// Fairshare docs.
+const (
+	DescFairshareTreeID       = "The path of the file, used as the ID."
+	DescFairshareTreePath     = "The path of the scheduler's fairshare tree file. Defaults to `/var/spool/pbs/sched_priv/resource_group`. The file must already exist. Changing this forces a new resource."
+	DescFairshareTreeEntities = "The users and groups in the fairshare tree. Names and IDs must be unique and every parent must be `root` or another entity in the tree."
+	DescFairshareEntityName   = "The name of the user or group."
+	DescFairshareEntityID     = "A unique ID for the entity. The scheduler stores usage against it so it should not be reused for a different entity."
+	DescFairshareEntityParent = "The group this entity belongs to, `root` for the top of the tree."
+	DescFairshareEntityShares = "The number of shares the entity has among its siblings."
+
+	DescFairshareUsageID         = "A fixed identifier for this data source."
+	DescFairshareUsageEntities   = "Every entity in the fairshare tree used by the scheduler, including `root` and the `unknown` group, in the order printed by `pbsfs`."
+	DescFairshareUsageParent     = "The name of the parent of the entity, `root` for the entities at the top of the tree and null for `root` itself."
+	DescFairshareUsageUsage      = "The usage accumulated by the entity, in the units of `fairshare_usage_res`."
+	DescFairshareUsagePercentage = "The entity's share of the whole tree as a percentage."
+)
+
+// Prime time docs.
+const (
+	DescHolidaysID             = "The path of the file, used as the ID."
+	DescHolidaysPath           = "The path of the scheduler's holidays file. Defaults to `/var/spool/pbs/sched_priv/holidays`. The file must already exist. Changing this forces a new resource."
+	DescHolidaysYear           = "The year the file is for. The scheduler logs a warning and treats all time as prime time once the year is over."
+	DescHolidaysPrimeTime      = "When prime and non-prime time start on each day. Each day may only be set once and `weekday` can't be used together with the individual weekdays."
+	DescPrimeTimeDay           = "The day, one of `weekday`, `monday`, `tuesday`, `wednesday`, `thursday`, `friday`, `saturday` or `sunday`."
+	DescPrimeTimePrimeStart    = "When prime time starts as `HHMM` in the server's local time, or `all` or `none`."
+	DescPrimeTimeNonPrimeStart = "When non-prime time starts as `HHMM` in the server's local time, or `all` or `none`."
+	DescHolidaysHolidays       = "Days which are non-prime all day. Every date must be in `year`."
+	DescHolidayDate            = "The date of the holiday as `YYYY-MM-DD`."
+	DescHolidayName            = "The name of the holiday."
+
+	DescDedicatedTimeID      = "The path of the file, used as the ID."
+	DescDedicatedTimePath    = "The path of the scheduler's dedicated time file. Defaults to `/var/spool/pbs/sched_priv/dedicated_time`. The file must already exist. Changing this forces a new resource."
+	DescDedicatedTimeWindows = "The dedicated time windows. Each window must end after it starts and windows can't overlap."
+	DescDedicatedTimeStart   = "When the window starts as `YYYY-MM-DDTHH:MM` in the server's local time."
+	DescDedicatedTimeEnd     = "When the window ends as `YYYY-MM-DDTHH:MM` in the server's local time."
+)
+
+// SSH connection docs.
+const (
+	DescSshConnection              = "Overrides how to connect to the host over SSH. Anything not set is taken from the provider."
+	DescSshConnectionPort          = "The SSH port of the host."
+	DescSshConnectionUsername      = "The user to connect as."
+	DescSshConnectionPassword      = "The password to connect with."
+	DescSshConnectionSshPrivateKey = "The SSH private key to connect with."
+)
+
+// MoM config docs.
+const (
+	DescMomConfigID                     = "The name of the node, used as the ID."
+	DescMomConfigNode                   = "The node whose MoM is configured. Changing this forces a new resource."
+	DescMomConfigHost                   = "The host to connect to over SSH. Defaults to the node's `Mom` attribute, or the node name if that isn't set. Changing this forces a new resource."
+	DescMomConfigPath                   = "The path of the MoM configuration file. Defaults to `/var/spool/pbs/mom_priv/config`. The file must already exist. Changing this forces a new resource."
+	DescMomConfigClientHost             = "Hosts allowed to connect to the MoM, each written as its own `$clienthost` line."
+	DescMomConfigRestrictUser           = "Whether processes not belonging to a job are killed, written as the `$restrict_user` directive."
+	DescMomConfigRestrictUserExceptions = "Users whose processes are not killed when `restrict_user` is enabled, written as the `$restrict_user_exceptions` directive."
+	DescMomConfigRestrictUserMaxSysID   = "Processes of users with an ID up to this are not killed when `restrict_user` is enabled, written as the `$restrict_user_maxsysid` directive."
+	DescMomConfigUseCp                  = "Paths which are copied locally rather than with scp, each written as its own `$usecp` line, e.g. `\"*:/home /home\"`."
+	DescMomConfigIdealLoad              = "The load below which the node is marked as free again, written as the `$ideal_load` directive."
+	DescMomConfigMaxLoad                = "The load above which the node is marked as busy, written as the `$max_load` directive."
+	DescMomConfigOptions                = "Any other directives, keyed by name without the `$`, e.g. `{ logevent = \"0x1ff\" }`. Directives with their own attribute can't be set here."
+)
+
+// Vnode definition docs.
+const (
+	DescVnodeDefinitionID       = "The node and the name of the file, as `<node>/<name>`."
+	DescVnodeDefinitionNode     = "The node whose MoM the file is inserted into. Changing this forces a new resource."
+	DescVnodeDefinitionName     = "The name the file is inserted into the MoM as. It may only contain letters, digits, `_`, `.` and `-` and can't start with `PBS`. Changing this forces a new resource."
+	DescVnodeDefinitionVnodes   = "The vnodes defined by the file, each must only be given once. The natural vnode, named after the host, may be included to set its attributes."
+	DescVnodeName               = "The name of the vnode, e.g. `node01[0]`."
+	DescVnodeResourcesAvailable = "Resources available on the vnode, keyed by resource name, e.g. `{ ncpus = \"16\", ngpus = \"2\" }`."
+	DescVnodeSharing            = "How the vnode is shared between jobs, one of `default_shared`, `default_excl`, `default_exclhost`, `ignore_excl`, `force_excl` or `force_exclhost`."
+	DescVnodePriority           = "The priority of the vnode when the scheduler sorts vnodes by priority."
+)
+
+// pbs.conf docs.
+const (
+	DescPbsConfID              = "The path of the file, prefixed with the host and a colon when `host` is set, e.g. `node01:/etc/pbs.conf`."
+	DescPbsConfHost            = "The host whose pbs.conf is managed, such as an execution or comm host. Defaults to the PBS server. Changing this forces a new resource."
+	DescPbsConfPath            = "The path of the file. Defaults to `/etc/pbs.conf`. The file must already exist. Changing this forces a new resource."
+	DescPbsConfSettings        = "The keys to set, e.g. `{ PBS_START_MOM = \"1\" }`. Other keys in the file are left as they are and removing a key stops managing it without changing the file."
+	DescPbsConfRestartRequired = "Whether the last change made by Terraform changed the file. PBS daemons only read pbs.conf when they start, so when this is true the daemons on the host need restarting for the change to take effect, e.g. with `pbs_daemon_action`."
+)
+
+// Daemon action docs.
+const (
+	DescDaemonActionID          = "The daemon and host, as `<daemon>@<host>` or `<daemon>@server`."
+	DescDaemonActionTriggers    = "Arbitrary values which rerun the action whenever they change, e.g. the `id` of a `pbs_sched_config` or the settings of a `pbs_conf`."
+	DescDaemonActionDaemon      = "The daemon to act on, one of `pbs_server`, `pbs_sched`, `pbs_comm` or `pbs_mom`. Changing this reruns the action."
+	DescDaemonActionAction      = "`hup` sends the daemon a `SIGHUP` so that it re-reads its configuration and fails if it isn't running. `restart` stops the daemon, leaving running jobs alone, and starts it again, a daemon that isn't running is just started. Changing this reruns the action."
+	DescDaemonActionHost        = "The host the daemon runs on. Defaults to the PBS server. Changing this reruns the action."
+	DescDaemonActionTimeout     = "The number of seconds to wait for the daemon to stop and become healthy again. Defaults to 300."
+	DescDaemonActionPreviousPid = "The PID of the daemon before the action, 0 if it wasn't running."
+	DescDaemonActionPid         = "The PID of the daemon once it was healthy again. It differs from `previous_pid` after a restart."
+	DescDaemonActionCompletedAt = "When the action last completed, as an RFC 3339 timestamp."
 )
 
 // PBS Resource docs.
@@ -46,10 +305,16 @@ const (
 	DescPbsResourceName = "The unique name of the resource on the server"
 	DescPbsResourceType = "What data type the resource takes, this can be one of boolean, string, long, size, float, string_array"
 	DescPbsResourceFlag = "One of the flags specifying where the resource is defined (f, fh, nh, q, m) and the ones defining it's visibility (i, r)"
+
+	DescPbsResourceSchedulable     = "Whether the scheduler checks this resource when placing jobs, by adding it to or removing it from the `resources` line in `sched_config`. Leave unset to not manage the line. A resource added to the line is taken off it again when it is destroyed."
+	DescPbsResourceSchedConfigPath = "The path of the scheduler configuration file that `schedulable` is managed in, the same file as the `path` of `pbs_sched_config`. Defaults to `/var/spool/pbs/sched_priv/sched_config`. The file must already exist."
 )
 
 // Queue docs.
 const (
+	DescQueueDestroyPolicy          = "What to do with jobs still in the queue when it is destroyed. `disable` disables the queue so no new jobs are accepted and waits for the remaining jobs to finish, `move` disables the queue and moves jobs which haven't started to `destroy_destination` with `qmove` then waits for running jobs to finish, and `fail` stops the destroy with the number of jobs in each state. Leave unset to delete the queue straight away, which PBS refuses if the queue has any jobs."
+	DescQueueDestroyTimeout         = "The number of seconds to wait for jobs to leave the queue when `destroy_policy` is `disable` or `move`. Defaults to 3600. The queue is left disabled if jobs remain after the timeout."
+	DescQueueDestroyDestination     = "The queue that waiting jobs are moved to when `destroy_policy` is `move`."
 	DescQueueID                     = "The unique identifier for this queue. This is the same as the name."
 	DescQueueAclGroupEnable         = "Controls whether group access to the queue obeys the access control list defined in the acl_groups queue attribute."
 	DescQueueAclGroups              = "List of groups which are allowed or denied access to this queue. The groups in the list are groups on the server host, not submitting hosts. List is evaluated left-to-right; first match in list is used."
@@ -104,6 +369,10 @@ const (
 	DescQueueRouteRetryTime         = "Time delay between routing retries. Typically used when the network between servers is down."
 	DescQueueRouteWaitingJobs       = "Specifies whether jobs whose `Execution_Time` attribute value is in the future can be routed from this queue."
 	DescQueueStarted                = "If this is an execution queue, specifies whether jobs in this queue can be scheduled for execution, or if this is a routing queue, whether jobs can be routed."
+	DescQueueTotalJobs              = "The number of jobs currently in the queue. Read only, reported by the server."
+	DescQueueStateCount             = "The number of jobs in the queue in each state, keyed by state name, e.g. `Queued` or `Running`. Read only, reported by the server."
+	DescQueueResourcesAssigned      = "The total of each resource allocated to jobs running in this queue. Read only, reported by the server."
+	DescQueueHasNodes               = "Whether any vnodes are associated with this queue. Read only, reported by the server."
 )
 
 // Server docs.
@@ -197,4 +466,11 @@ const (
 	DescServerWebapiEnable                  = "Enables or disables web API support in PBS"
 	DescServerWebapiOidcClientid            = "Used with external OIDC service. The client identifier generated when registering the application with the OIDC provider. For validation of OIDC ID tokens passed in http(s) requests."
 	DescServerWebapiOidcProviderUrl         = "Used with external OIDC service. URL of the OIDC provider, for example https://accounts.google.com For validation of OIDC ID tokens passed in http(s) requests."
+	DescServerServerState                   = "The current state of the server, e.g. `Active`, `Idle`, `Scheduling` or `Terminating`. Read only, reported by the server."
+	DescServerServerHost                    = "The host the server is running on. Read only, reported by the server."
+	DescServerTotalJobs                     = "The number of jobs currently managed by the server. Read only, reported by the server."
+	DescServerStateCount                    = "The number of jobs in each state across all queues, keyed by state name, e.g. `Queued` or `Running`. Read only, reported by the server."
+	DescServerPbsVersion                    = "The version of PBS the server is running. Read only, reported by the server."
+	DescServerLicenseCount                  = "License usage, keyed by counter name, e.g. `Avail_Global`, `Avail_Local`, `Used` and `High_Use`. Read only, reported by the server."
+	DescServerResourcesAssigned             = "The total of each resource allocated to running jobs across the complex. Read only, reported by the server."
 )
@@ -0,0 +1,327 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-pbs/internal/pbsclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &fairshareTreeResource{}
+	_ resource.ResourceWithConfigure      = &fairshareTreeResource{}
+	_ resource.ResourceWithImportState    = &fairshareTreeResource{}
+	_ resource.ResourceWithValidateConfig = &fairshareTreeResource{}
+)
+
+var fairshareNameRegex = regexp.MustCompile(`^[^\s#]+$`)
+
+func NewFairshareTreeResource() resource.Resource {
+	return &fairshareTreeResource{}
+}
+
+// fairshareTreeResource owns the scheduler's resource_group file, which defines the fairshare tree. The whole file
+// is written from the configured entities so anything added to it by hand is removed.
+type fairshareTreeResource struct {
+	client *pbsclient.PbsClient
+}
+
+type fairshareTreeModel struct {
+	ID       types.String           `tfsdk:"id"`
+	Path     types.String           `tfsdk:"path"`
+	Entities []fairshareEntityModel `tfsdk:"entities"`
+}
+
+type fairshareEntityModel struct {
+	Name   types.String `tfsdk:"name"`
+	ID     types.Int32  `tfsdk:"id"`
+	Parent types.String `tfsdk:"parent"`
+	Shares types.Int32  `tfsdk:"shares"`
+}
+
+func (m fairshareTreeModel) ToFairshareEntities() []pbsclient.FairshareEntity {
+	entities := make([]pbsclient.FairshareEntity, 0, len(m.Entities))
+	for _, e := range m.Entities {
+		entities = append(entities, pbsclient.FairshareEntity{
+			Name:   e.Name.ValueString(),
+			ID:     int(e.ID.ValueInt32()),
+			Parent: e.Parent.ValueString(),
+			Shares: int(e.Shares.ValueInt32()),
+		})
+	}
+
+	return entities
+}
+
+func createFairshareTreeModel(filePath types.String, entities []pbsclient.FairshareEntity) fairshareTreeModel {
+	model := fairshareTreeModel{
+		ID:       filePath,
+		Path:     filePath,
+		Entities: []fairshareEntityModel{},
+	}
+
+	for _, e := range entities {
+		model.Entities = append(model.Entities, fairshareEntityModel{
+			Name:   types.StringValue(e.Name),
+			ID:     types.Int32Value(int32(e.ID)),
+			Parent: types.StringValue(e.Parent),
+			Shares: types.Int32Value(int32(e.Shares)),
+		})
+	}
+
+	return model
+}
+
+func (r *fairshareTreeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fairshare_tree"
+}
+
+func (r *fairshareTreeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescFairshareTreeID,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(pbsclient.DefaultResourceGroupPath),
+				MarkdownDescription: DescFairshareTreePath,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(absolutePathRegex, "must be an absolute path"),
+				},
+			},
+			"entities": schema.SetNestedAttribute{
+				Required:            true,
+				MarkdownDescription: DescFairshareTreeEntities,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: DescFairshareEntityName,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(fairshareNameRegex, "must not contain whitespace or #"),
+								stringvalidator.NoneOf(pbsclient.FairshareRoot, "TREEROOT", "unknown"),
+							},
+						},
+						"id": schema.Int32Attribute{
+							Required:            true,
+							MarkdownDescription: DescFairshareEntityID,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(1),
+							},
+						},
+						"parent": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: DescFairshareEntityParent,
+						},
+						"shares": schema.Int32Attribute{
+							Required:            true,
+							MarkdownDescription: DescFairshareEntityShares,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(0),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *fairshareTreeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pbsclient.PbsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pbsclient.PbsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *fairshareTreeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// The tree can only be checked once every entity is known
+	var entities types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("entities"), &entities)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if raw, err := entities.ToTerraformValue(ctx); err != nil || !raw.IsFullyKnown() {
+		return
+	}
+
+	var data fairshareTreeModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateFairshareTree(data.ToFairshareEntities())...)
+}
+
+func (r *fairshareTreeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model fairshareTreeModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := warnIfSchedulerNotRunning(&resp.Diagnostics, r.client.UpdateResourceGroup(model.Path.ValueString(), model.ToFairshareEntities()))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating resource", fmt.Sprintf("Could not write %s, unexpected error: %s", model.Path.ValueString(), err))
+		return
+	}
+
+	model.ID = model.Path
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *fairshareTreeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state fairshareTreeModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// For import, the ID is the path of the file
+	if state.Path.IsNull() {
+		state.Path = state.ID
+	}
+
+	entities, err := r.client.GetResourceGroup(state.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s, got error: %s", state.Path.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createFairshareTreeModel(state.Path, entities))...)
+}
+
+func (r *fairshareTreeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan fairshareTreeModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := warnIfSchedulerNotRunning(&resp.Diagnostics, r.client.UpdateResourceGroup(plan.Path.ValueString(), plan.ToFairshareEntities()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the fairshare tree. "+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.Path
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *fairshareTreeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data fairshareTreeModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An empty tree puts every user back in the unknown group
+	err := warnIfSchedulerNotRunning(&resp.Diagnostics, r.client.UpdateResourceGroup(data.Path.ValueString(), nil))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to empty %s, got error: %s", data.Path.ValueString(), err))
+		return
+	}
+}
+
+func (r *fairshareTreeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the standard passthrough for ID, which will set both id and trigger a Read
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// validateFairshareTree checks that names and IDs are unique and that every entity's parent is either root or
+// another entity, without any loops.
+func validateFairshareTree(entities []pbsclient.FairshareEntity) diag.Diagnostics {
+	var diags diag.Diagnostics
+	entitiesPath := path.Root("entities")
+
+	names := map[string]bool{}
+	ids := map[int]string{}
+	for _, e := range entities {
+		if names[e.Name] {
+			diags.AddAttributeError(entitiesPath, "Duplicate Fairshare Entity", fmt.Sprintf("%s is in the tree more than once.", e.Name))
+		}
+		names[e.Name] = true
+
+		if other, ok := ids[e.ID]; ok {
+			diags.AddAttributeError(entitiesPath, "Duplicate Fairshare ID", fmt.Sprintf("%s and %s both have the ID %d.", other, e.Name, e.ID))
+		}
+		ids[e.ID] = e.Name
+	}
+
+	for _, e := range entities {
+		if e.Parent != pbsclient.FairshareRoot && !names[e.Parent] {
+			diags.AddAttributeError(entitiesPath, "Unknown Fairshare Parent", fmt.Sprintf("The parent of %s, %s, must be %q or another entity in the tree.", e.Name, e.Parent, pbsclient.FairshareRoot))
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	// Every entity is reachable from root unless a group is its own ancestor
+	parents := map[string]string{}
+	for _, e := range entities {
+		parents[e.Name] = e.Parent
+	}
+	for _, e := range entities {
+		seen := map[string]bool{}
+		for name := e.Name; name != pbsclient.FairshareRoot; name = parents[name] {
+			if seen[name] {
+				// An entity below a loop is reported by the entities in the loop
+				if name == e.Name {
+					diags.AddAttributeError(entitiesPath, "Fairshare Loop", fmt.Sprintf("%s is its own ancestor.", e.Name))
+				}
+				break
+			}
+			seen[name] = true
+		}
+	}
+
+	return diags
+}
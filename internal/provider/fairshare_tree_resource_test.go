@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-pbs/internal/pbsclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestValidateFairshareTree(t *testing.T) {
+	tests := []struct {
+		name     string
+		entities []pbsclient.FairshareEntity
+		want     string
+	}{
+		{"valid", []pbsclient.FairshareEntity{
+			{Name: "theory", ID: 101, Parent: "physics", Shares: 20},
+			{Name: "physics", ID: 100, Parent: "root", Shares: 60},
+		}, ""},
+		{"duplicate name", []pbsclient.FairshareEntity{
+			{Name: "physics", ID: 100, Parent: "root", Shares: 60},
+			{Name: "physics", ID: 101, Parent: "root", Shares: 20},
+		}, "Duplicate Fairshare Entity"},
+		{"duplicate id", []pbsclient.FairshareEntity{
+			{Name: "physics", ID: 100, Parent: "root", Shares: 60},
+			{Name: "chemistry", ID: 100, Parent: "root", Shares: 20},
+		}, "Duplicate Fairshare ID"},
+		{"missing parent", []pbsclient.FairshareEntity{
+			{Name: "theory", ID: 101, Parent: "physics", Shares: 20},
+		}, "Unknown Fairshare Parent"},
+		{"loop", []pbsclient.FairshareEntity{
+			{Name: "a", ID: 1, Parent: "b", Shares: 1},
+			{Name: "b", ID: 2, Parent: "a", Shares: 1},
+			{Name: "c", ID: 3, Parent: "a", Shares: 1},
+		}, "Fairshare Loop"},
+	}
+
+	for _, tt := range tests {
+		diags := validateFairshareTree(tt.entities)
+		if tt.want == "" {
+			if diags.HasError() {
+				t.Errorf("%s: unexpected errors %v", tt.name, diags)
+			}
+			continue
+		}
+		if !diags.HasError() || diags.Errors()[0].Summary() != tt.want {
+			t.Errorf("%s: got %v, wanted %q", tt.name, diags, tt.want)
+		}
+	}
+
+	// Only the entities in the loop are reported
+	loop := validateFairshareTree(tests[4].entities)
+	if got := loop.ErrorsCount(); got != 2 {
+		t.Errorf("got %d errors, wanted 2", got)
+	}
+}
+
+func TestAccFairshareTreeResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFairshareTreeResourceConfig(60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_fairshare_tree.test", "id", pbsclient.DefaultResourceGroupPath),
+					resource.TestCheckResourceAttr("pbs_fairshare_tree.test", "entities.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("pbs_fairshare_tree.test", "entities.*", map[string]string{
+						"name":   "physics",
+						"id":     "100",
+						"parent": "root",
+						"shares": "60",
+					}),
+				),
+			},
+			{
+				ResourceName:      "pbs_fairshare_tree.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFairshareTreeResourceConfig(40),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("pbs_fairshare_tree.test", "entities.*", map[string]string{
+						"name":   "physics",
+						"shares": "40",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFairshareTreeResource_invalidParent(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig() + `
+resource "pbs_fairshare_tree" "test" {
+  entities = [
+    { name = "theory", id = 101, parent = "physcis", shares = 20 },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile(`Unknown Fairshare Parent`),
+			},
+		},
+	})
+}
+
+func testAccFairshareTreeResourceConfig(shares int) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_fairshare_tree" "test" {
+  entities = [
+    { name = "physics", id = 100, parent = "root", shares = %[1]d },
+    { name = "theory", id = 101, parent = "physics", shares = 20 },
+  ]
+}
+`, shares)
+}
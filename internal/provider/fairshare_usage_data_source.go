@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-pbs/internal/pbsclient"
+)
+
+func NewFairshareUsageDataSource() datasource.DataSource {
+	return &fairshareUsageDataSource{}
+}
+
+// fairshareUsageDataSource reports the fairshare tree the scheduler is using along with each entity's usage, as
+// printed by pbsfs.
+type fairshareUsageDataSource struct {
+	client *pbsclient.PbsClient
+}
+
+type fairshareUsageDataSourceModel struct {
+	ID       types.String                `tfsdk:"id"`
+	Entities []fairshareUsageEntityModel `tfsdk:"entities"`
+}
+
+type fairshareUsageEntityModel struct {
+	Name       types.String  `tfsdk:"name"`
+	ID         types.Int32   `tfsdk:"id"`
+	Parent     types.String  `tfsdk:"parent"`
+	Shares     types.Int32   `tfsdk:"shares"`
+	Usage      types.Float64 `tfsdk:"usage"`
+	Percentage types.Float64 `tfsdk:"percentage"`
+}
+
+func (d *fairshareUsageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fairshare_usage"
+}
+
+func (d *fairshareUsageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescFairshareUsageID,
+			},
+			"entities": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: DescFairshareUsageEntities,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescFairshareEntityName,
+						},
+						"id": schema.Int32Attribute{
+							Computed:            true,
+							MarkdownDescription: DescFairshareEntityID,
+						},
+						"parent": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescFairshareUsageParent,
+						},
+						"shares": schema.Int32Attribute{
+							Computed:            true,
+							MarkdownDescription: DescFairshareEntityShares,
+						},
+						"usage": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: DescFairshareUsageUsage,
+						},
+						"percentage": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: DescFairshareUsagePercentage,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *fairshareUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	data := fairshareUsageDataSourceModel{}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	usage, err := d.client.GetFairshareUsage()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to connect to PBS server and get fairshare usage", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue("fairshare_usage")
+	data.Entities = []fairshareUsageEntityModel{}
+	for _, u := range usage {
+		parent := types.StringNull()
+		if u.Parent != "" {
+			parent = types.StringValue(u.Parent)
+		}
+
+		data.Entities = append(data.Entities, fairshareUsageEntityModel{
+			Name:       types.StringValue(u.Name),
+			ID:         types.Int32Value(int32(u.ID)),
+			Parent:     parent,
+			Shares:     types.Int32Value(int32(u.Shares)),
+			Usage:      types.Float64Value(u.Usage),
+			Percentage: types.Float64Value(u.Percentage),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *fairshareUsageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pbsclient.PbsClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *PbsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,380 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-pbs/internal/pbsclient"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &holidaysResource{}
+	_ resource.ResourceWithConfigure      = &holidaysResource{}
+	_ resource.ResourceWithImportState    = &holidaysResource{}
+	_ resource.ResourceWithValidateConfig = &holidaysResource{}
+)
+
+var (
+	primeTimeRegex   = regexp.MustCompile(`^(([01][0-9]|2[0-3])[0-5][0-9]|all|none)$`)
+	holidayDateRegex = regexp.MustCompile(`^[0-9]{4}-[0-9]{2}-[0-9]{2}$`)
+	holidayNameRegex = regexp.MustCompile(`^[^\n*]+$`)
+)
+
+func NewHolidaysResource() resource.Resource {
+	return &holidaysResource{}
+}
+
+// holidaysResource owns the scheduler's holidays file, which sets when prime time starts and ends on each day and
+// which days of the year are non-prime all day.
+type holidaysResource struct {
+	client *pbsclient.PbsClient
+}
+
+type holidaysModel struct {
+	ID        types.String             `tfsdk:"id"`
+	Path      types.String             `tfsdk:"path"`
+	Year      types.Int32              `tfsdk:"year"`
+	PrimeTime []primeTimeScheduleModel `tfsdk:"prime_time"`
+	Holidays  []holidayModel           `tfsdk:"holidays"`
+}
+
+type primeTimeScheduleModel struct {
+	Day           types.String `tfsdk:"day"`
+	PrimeStart    types.String `tfsdk:"prime_start"`
+	NonPrimeStart types.String `tfsdk:"non_prime_start"`
+}
+
+type holidayModel struct {
+	Date types.String `tfsdk:"date"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (m holidaysModel) ToHolidays() (pbsclient.Holidays, error) {
+	h := pbsclient.Holidays{Year: int(m.Year.ValueInt32())}
+
+	for _, s := range m.PrimeTime {
+		h.Schedules = append(h.Schedules, pbsclient.PrimeTimeSchedule{
+			Day:           s.Day.ValueString(),
+			PrimeStart:    s.PrimeStart.ValueString(),
+			NonPrimeStart: s.NonPrimeStart.ValueString(),
+		})
+	}
+	for _, holiday := range m.Holidays {
+		date, err := time.Parse(pbsclient.HolidayDateFormat, holiday.Date.ValueString())
+		if err != nil {
+			return h, fmt.Errorf("holiday date %q must be in the form YYYY-MM-DD", holiday.Date.ValueString())
+		}
+		h.Holidays = append(h.Holidays, pbsclient.Holiday{Date: date, Name: holiday.Name.ValueString()})
+	}
+
+	return h, nil
+}
+
+// createHolidaysModel creates the model from the file. holidays is left null when there are none and it isn't set
+// in prior.
+func createHolidaysModel(filePath types.String, h pbsclient.Holidays, prior holidaysModel) holidaysModel {
+	model := holidaysModel{
+		ID:        filePath,
+		Path:      filePath,
+		Year:      types.Int32Value(int32(h.Year)),
+		PrimeTime: []primeTimeScheduleModel{},
+	}
+
+	for _, s := range h.Schedules {
+		model.PrimeTime = append(model.PrimeTime, primeTimeScheduleModel{
+			Day:           types.StringValue(s.Day),
+			PrimeStart:    types.StringValue(s.PrimeStart),
+			NonPrimeStart: types.StringValue(s.NonPrimeStart),
+		})
+	}
+	if len(h.Holidays) > 0 || prior.Holidays != nil {
+		model.Holidays = []holidayModel{}
+	}
+	for _, holiday := range h.Holidays {
+		model.Holidays = append(model.Holidays, holidayModel{
+			Date: types.StringValue(holiday.Date.Format(pbsclient.HolidayDateFormat)),
+			Name: types.StringValue(holiday.Name),
+		})
+	}
+
+	return model
+}
+
+func (r *holidaysResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_holidays"
+}
+
+func (r *holidaysResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescHolidaysID,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(pbsclient.DefaultHolidaysPath),
+				MarkdownDescription: DescHolidaysPath,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(absolutePathRegex, "must be an absolute path"),
+				},
+			},
+			"year": schema.Int32Attribute{
+				Required:            true,
+				MarkdownDescription: DescHolidaysYear,
+				Validators: []validator.Int32{
+					int32validator.Between(1970, 9999),
+				},
+			},
+			"prime_time": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: DescHolidaysPrimeTime,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"day": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: DescPrimeTimeDay,
+							Validators: []validator.String{
+								stringvalidator.OneOf(pbsclient.PrimeTimeDays...),
+							},
+						},
+						"prime_start": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: DescPrimeTimePrimeStart,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(primeTimeRegex, "must be a time as HHMM, all or none"),
+							},
+						},
+						"non_prime_start": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: DescPrimeTimeNonPrimeStart,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(primeTimeRegex, "must be a time as HHMM, all or none"),
+							},
+						},
+					},
+				},
+			},
+			"holidays": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: DescHolidaysHolidays,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"date": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: DescHolidayDate,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(holidayDateRegex, "must be a date as YYYY-MM-DD"),
+							},
+						},
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: DescHolidayName,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(holidayNameRegex, "must be a single line without *"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *holidaysResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pbsclient.PbsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pbsclient.PbsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *holidaysResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// The schedule can only be checked once it is fully known
+	var year types.Int32
+	var primeTime, holidays types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("year"), &year)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("prime_time"), &primeTime)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("holidays"), &holidays)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, v := range []attr.Value{year, primeTime, holidays} {
+		if raw, err := v.ToTerraformValue(ctx); err != nil || !raw.IsFullyKnown() {
+			return
+		}
+	}
+
+	var data holidaysModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	h, err := data.ToHolidays()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("holidays"), "Invalid Holiday", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(validateHolidays(h)...)
+}
+
+func (r *holidaysResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model holidaysModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	h, err := model.ToHolidays()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Holidays", err.Error())
+		return
+	}
+
+	err = warnIfSchedulerNotRunning(&resp.Diagnostics, r.client.UpdateHolidays(model.Path.ValueString(), h))
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating resource", fmt.Sprintf("Could not write %s, unexpected error: %s", model.Path.ValueString(), err))
+		return
+	}
+
+	model.ID = model.Path
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *holidaysResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state holidaysModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// For import, the ID is the path of the file
+	if state.Path.IsNull() {
+		state.Path = state.ID
+	}
+
+	h, err := r.client.GetHolidays(state.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s, got error: %s", state.Path.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createHolidaysModel(state.Path, h, state))...)
+}
+
+func (r *holidaysResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan holidaysModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	h, err := plan.ToHolidays()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Holidays", err.Error())
+		return
+	}
+
+	err = warnIfSchedulerNotRunning(&resp.Diagnostics, r.client.UpdateHolidays(plan.Path.ValueString(), h))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the holidays file. "+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.Path
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *holidaysResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Without a holidays file the scheduler treats all time as prime time, which is rarely what is wanted, so the
+	// file is left as it is
+	resp.Diagnostics.AddWarning(
+		"Holidays Not Changed",
+		"The holidays file has been removed from Terraform state but is unchanged on the server.",
+	)
+}
+
+func (r *holidaysResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the standard passthrough for ID, which will set both id and trigger a Read
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// validateHolidays checks that each day's prime time is only set once, that weekday isn't mixed with the
+// individual weekdays and that every holiday is a different day in the year.
+func validateHolidays(h pbsclient.Holidays) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	days := map[string]bool{}
+	for _, s := range h.Schedules {
+		if days[s.Day] {
+			diags.AddAttributeError(path.Root("prime_time"), "Duplicate Prime Time", fmt.Sprintf("Prime time for %s is set more than once.", s.Day))
+		}
+		days[s.Day] = true
+	}
+	if days["weekday"] {
+		for _, day := range []string{"monday", "tuesday", "wednesday", "thursday", "friday"} {
+			if days[day] {
+				diags.AddAttributeError(path.Root("prime_time"), "Duplicate Prime Time", fmt.Sprintf("Prime time for %s is set by both weekday and %s.", day, day))
+			}
+		}
+	}
+
+	dates := map[string]bool{}
+	for _, holiday := range h.Holidays {
+		date := holiday.Date.Format(pbsclient.HolidayDateFormat)
+		if holiday.Date.Year() != h.Year {
+			diags.AddAttributeError(path.Root("holidays"), "Holiday Outside Year", fmt.Sprintf("%s (%s) is not in %d, the holidays file only covers a single year.", holiday.Name, date, h.Year))
+		}
+		if dates[date] {
+			diags.AddAttributeError(path.Root("holidays"), "Duplicate Holiday", fmt.Sprintf("%s is a holiday more than once.", date))
+		}
+		dates[date] = true
+	}
+
+	return diags
+}
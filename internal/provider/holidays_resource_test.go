@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-pbs/internal/pbsclient"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestValidateHolidays(t *testing.T) {
+	newYear := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		holidays pbsclient.Holidays
+		want     string
+	}{
+		{"valid", pbsclient.Holidays{
+			Year:      2026,
+			Schedules: []pbsclient.PrimeTimeSchedule{{Day: "weekday", PrimeStart: "0600", NonPrimeStart: "1730"}, {Day: "saturday", PrimeStart: "none", NonPrimeStart: "all"}},
+			Holidays:  []pbsclient.Holiday{{Date: newYear, Name: "New Year's Day"}},
+		}, ""},
+		{"weekday and monday", pbsclient.Holidays{
+			Year:      2026,
+			Schedules: []pbsclient.PrimeTimeSchedule{{Day: "weekday", PrimeStart: "0600", NonPrimeStart: "1730"}, {Day: "monday", PrimeStart: "0800", NonPrimeStart: "1730"}},
+		}, "Duplicate Prime Time"},
+		{"wrong year", pbsclient.Holidays{
+			Year:     2027,
+			Holidays: []pbsclient.Holiday{{Date: newYear, Name: "New Year's Day"}},
+		}, "Holiday Outside Year"},
+		{"duplicate holiday", pbsclient.Holidays{
+			Year:     2026,
+			Holidays: []pbsclient.Holiday{{Date: newYear, Name: "New Year's Day"}, {Date: newYear, Name: "Again"}},
+		}, "Duplicate Holiday"},
+	}
+
+	for _, tt := range tests {
+		diags := validateHolidays(tt.holidays)
+		if tt.want == "" {
+			if diags.HasError() {
+				t.Errorf("%s: unexpected errors %v", tt.name, diags)
+			}
+			continue
+		}
+		if !diags.HasError() || diags.Errors()[0].Summary() != tt.want {
+			t.Errorf("%s: got %v, wanted %q", tt.name, diags, tt.want)
+		}
+	}
+}
+
+func TestAccHolidaysResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHolidaysResourceConfig("1730"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_holidays.test", "id", pbsclient.DefaultHolidaysPath),
+					resource.TestCheckResourceAttr("pbs_holidays.test", "prime_time.0.non_prime_start", "1730"),
+					resource.TestCheckResourceAttr("pbs_holidays.test", "holidays.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "pbs_holidays.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccHolidaysResourceConfig("1800"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_holidays.test", "prime_time.0.non_prime_start", "1800"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHolidaysResource_invalidYear(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig() + `
+resource "pbs_holidays" "test" {
+  year = 2026
+
+  prime_time = [
+    { day = "weekday", prime_start = "0600", non_prime_start = "1730" },
+  ]
+
+  holidays = [
+    { date = "2027-01-01", name = "New Year's Day" },
+  ]
+}
+`,
+				ExpectError: regexp.MustCompile(`Holiday Outside Year`),
+			},
+		},
+	})
+}
+
+func testAccHolidaysResourceConfig(nonPrimeStart string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_holidays" "test" {
+  year = 2026
+
+  prime_time = [
+    { day = "weekday", prime_start = "0600", non_prime_start = %[1]q },
+    { day = "saturday", prime_start = "none", non_prime_start = "all" },
+    { day = "sunday", prime_start = "none", non_prime_start = "all" },
+  ]
+
+  holidays = [
+    { date = "2026-01-01", name = "New Year's Day" },
+    { date = "2026-12-25", name = "Christmas Day" },
+  ]
+}
+`, nonPrimeStart)
+}
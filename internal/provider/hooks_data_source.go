@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-pbs/internal/pbsclient"
+)
+
+func NewPbsHooksDataSource() datasource.DataSource {
+	return &pbsHooksDataSource{}
+}
+
+type pbsHooksDataSource struct {
+	client *pbsclient.PbsClient
+}
+
+type pbsHooksDataSourceModel struct {
+	ID      types.String   `tfsdk:"id"`
+	Type    types.String   `tfsdk:"type"`
+	Enabled types.Bool     `tfsdk:"enabled"`
+	Event   types.String   `tfsdk:"event"`
+	Names   []types.String `tfsdk:"names"`
+	Hooks   []pbsHookModel `tfsdk:"hooks"`
+}
+
+func (d *pbsHooksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hooks"
+}
+
+func (d *pbsHooksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescHooksID,
+			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: DescHooksType,
+				Validators: []validator.String{
+					stringvalidator.OneOf("site", "pbs"),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: DescHooksEnabled,
+			},
+			"event": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: DescHooksEvent,
+			},
+			"names": schema.ListAttribute{
+				Computed:            true,
+				MarkdownDescription: DescHooksNames,
+				ElementType:         types.StringType,
+			},
+			"hooks": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: DescHooksHooks,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescHookID,
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescHookName,
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescHookType,
+						},
+						"alarm": schema.Int32Attribute{
+							Computed:            true,
+							MarkdownDescription: DescHookAlarm,
+						},
+						"debug": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: DescHookDebug,
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: DescHookEnabled,
+						},
+						"event": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescHookEvent,
+						},
+						"fail_action": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescHookFailAction,
+						},
+						"freq": schema.Int32Attribute{
+							Computed:            true,
+							MarkdownDescription: DescHookFreq,
+						},
+						"order": schema.Int32Attribute{
+							Computed:            true,
+							MarkdownDescription: DescHookOrder,
+						},
+						"user": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescHookUser,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *pbsHooksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	data := pbsHooksDataSourceModel{}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all, err := d.client.GetHooks()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to connect to PBS server and get hook information", err.Error())
+		return
+	}
+
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+
+	data.ID = types.StringValue("hooks")
+	data.Names = []types.String{}
+	data.Hooks = []pbsHookModel{}
+	for _, h := range all {
+		if !data.Type.IsNull() && (h.Type == nil || *h.Type != data.Type.ValueString()) {
+			continue
+		}
+		if !data.Enabled.IsNull() && (h.Enabled == nil || *h.Enabled != data.Enabled.ValueBool()) {
+			continue
+		}
+		// A hook can be triggered by several events, it matches if any one of them is the requested event
+		if !data.Event.IsNull() && (h.Event == nil || !slices.Contains(strings.Split(normalizeCommaSeparatedString(*h.Event), ","), data.Event.ValueString())) {
+			continue
+		}
+
+		data.Names = append(data.Names, types.StringValue(h.Name))
+		data.Hooks = append(data.Hooks, createPbsHookModel(h))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *pbsHooksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pbsclient.PbsClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *PbsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxHostlistSize limits how many hostnames a single hostlist expression may expand to, protecting against
+// typos such as cn[1-1000000].
+const maxHostlistSize = 100000
+
+// hostnameNumberRegex splits a hostname around its last run of digits, e.g. "r1n05-ib" gives "r1n", "05" and "-ib".
+var hostnameNumberRegex = regexp.MustCompile(`^(.*?)([0-9]+)([^0-9]*)$`)
+
+// splitHostlist splits a hostlist expression on the commas which separate terms, ignoring commas inside
+// brackets, e.g. "cn[1-2,5],gpu01" gives "cn[1-2,5]" and "gpu01".
+func splitHostlist(expr string) ([]string, error) {
+	terms := []string{}
+	depth := 0
+	start := 0
+	for i, r := range expr {
+		switch r {
+		case '[':
+			depth++
+			if depth > 1 {
+				return nil, fmt.Errorf("nested brackets are not supported in %q", expr)
+			}
+		case ']':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unexpected ] in %q", expr)
+			}
+		case ',':
+			if depth == 0 {
+				terms = append(terms, expr[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unclosed [ in %q", expr)
+	}
+
+	return append(terms, expr[start:]), nil
+}
+
+// expandHostlistRange expands the contents of a single bracket, e.g. "001-003,7" gives 001, 002, 003 and 7.
+// Numbers are zero padded to the width of the lower bound. An error is returned once the parts together expand
+// to more than limit values.
+func expandHostlistRange(rng string, limit int) ([]string, error) {
+	values := []string{}
+	for _, part := range strings.Split(rng, ",") {
+		part = strings.TrimSpace(part)
+		lowText, highText, isRange := strings.Cut(part, "-")
+		if !isRange {
+			highText = lowText
+		}
+
+		low, err := strconv.Atoi(lowText)
+		if err != nil || low < 0 {
+			return nil, fmt.Errorf("invalid number %q in range [%s]", lowText, rng)
+		}
+		high, err := strconv.Atoi(highText)
+		if err != nil || high < 0 {
+			return nil, fmt.Errorf("invalid number %q in range [%s]", highText, rng)
+		}
+		if high < low {
+			return nil, fmt.Errorf("range %s is reversed in [%s]", part, rng)
+		}
+		// Compared against what is left of limit so that large bounds can't overflow the total
+		if high-low >= limit-len(values) {
+			return nil, fmt.Errorf("[%s] expands to more than %d hosts", rng, limit)
+		}
+
+		width := len(lowText)
+		for i := low; i <= high; i++ {
+			values = append(values, fmt.Sprintf("%0*d", width, i))
+		}
+	}
+
+	return values, nil
+}
+
+// expandHostlist expands a hostlist expression such as "cn[001-512]" or "rack[1-2]n[01-04],login01" into the
+// individual hostnames, in order. Multiple bracket groups in a term expand to every combination.
+func expandHostlist(expr string) ([]string, error) {
+	terms, err := splitHostlist(strings.TrimSpace(expr))
+	if err != nil {
+		return nil, err
+	}
+
+	hosts := []string{}
+	for _, term := range terms {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			return nil, fmt.Errorf("empty hostname in %q", expr)
+		}
+
+		expanded := []string{""}
+		rest := term
+		for rest != "" {
+			open := strings.Index(rest, "[")
+			if open < 0 {
+				for i := range expanded {
+					expanded[i] += rest
+				}
+				break
+			}
+
+			closing := strings.Index(rest, "]")
+			// Check the running total before building every combination so that cn[1-99999]x[1-99999] fails fast
+			remaining := maxHostlistSize - len(hosts)
+			values, err := expandHostlistRange(rest[open+1:closing], remaining)
+			if err != nil {
+				return nil, err
+			}
+			if len(expanded)*len(values) > remaining {
+				return nil, fmt.Errorf("%q expands to more than %d hosts", expr, maxHostlistSize)
+			}
+
+			next := make([]string, 0, len(expanded)*len(values))
+			for _, prefix := range expanded {
+				for _, v := range values {
+					next = append(next, prefix+rest[:open]+v)
+				}
+			}
+			expanded = next
+			rest = rest[closing+1:]
+		}
+
+		hosts = append(hosts, expanded...)
+		if len(hosts) > maxHostlistSize {
+			return nil, fmt.Errorf("%q expands to more than %d hosts", expr, maxHostlistSize)
+		}
+	}
+
+	return hosts, nil
+}
+
+// hostlistRun is a run of consecutive numbers which compacts to a single range, e.g. "001-016".
+type hostlistRun struct {
+	lowText string
+	low     int
+	high    int
+}
+
+func (r hostlistRun) String() string {
+	if r.low == r.high {
+		return r.lowText
+	}
+	return fmt.Sprintf("%s-%0*d", r.lowText, len(r.lowText), r.high)
+}
+
+// compactHostlist is the inverse of expandHostlist, it compacts hostnames into a hostlist expression such as
+// "gpu[01-16,20],login01". Hostnames are deduplicated and sorted by prefix and number. Numbers are only merged
+// into a range when expanding the range gives back exactly the same hostnames, so "n1" and "n01" are kept apart.
+func compactHostlist(hosts []string) (string, error) {
+	type numberedHost struct {
+		number int
+		text   string
+	}
+
+	plain := map[string]bool{}
+	groups := map[[2]string][]numberedHost{}
+	for _, host := range hosts {
+		host = strings.TrimSpace(host)
+		if host == "" {
+			return "", fmt.Errorf("empty hostname")
+		}
+		if strings.ContainsAny(host, "[],") {
+			return "", fmt.Errorf("hostname %q must not contain brackets or commas", host)
+		}
+
+		match := hostnameNumberRegex.FindStringSubmatch(host)
+		if match == nil {
+			plain[host] = true
+			continue
+		}
+		number, err := strconv.Atoi(match[2])
+		if err != nil {
+			plain[host] = true
+			continue
+		}
+
+		key := [2]string{match[1], match[3]}
+		groups[key] = append(groups[key], numberedHost{number: number, text: match[2]})
+	}
+
+	terms := map[string]string{}
+	for name := range plain {
+		terms[name] = name
+	}
+	for key, members := range groups {
+		sort.Slice(members, func(i, j int) bool {
+			if members[i].number != members[j].number {
+				return members[i].number < members[j].number
+			}
+			return members[i].text < members[j].text
+		})
+
+		runs := []hostlistRun{}
+		for i, m := range members {
+			if i > 0 && m.text == members[i-1].text {
+				continue
+			}
+			if len(runs) > 0 {
+				last := &runs[len(runs)-1]
+				if m.number == last.high+1 && fmt.Sprintf("%0*d", len(last.lowText), m.number) == m.text {
+					last.high = m.number
+					continue
+				}
+			}
+			runs = append(runs, hostlistRun{lowText: m.text, low: m.number, high: m.number})
+		}
+
+		if len(runs) == 1 && runs[0].low == runs[0].high {
+			name := key[0] + runs[0].lowText + key[1]
+			terms[name] = name
+			continue
+		}
+
+		ranges := make([]string, 0, len(runs))
+		for _, run := range runs {
+			ranges = append(ranges, run.String())
+		}
+		// Key the term on its prefix so that it sorts alongside plain hostnames with the same prefix
+		terms[key[0]+"\x00"+key[1]] = key[0] + "[" + strings.Join(ranges, ",") + "]" + key[1]
+	}
+
+	keys := make([]string, 0, len(terms))
+	for k := range terms {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	result := make([]string, 0, len(keys))
+	for _, k := range keys {
+		result = append(result, terms[k])
+	}
+
+	return strings.Join(result, ","), nil
+}
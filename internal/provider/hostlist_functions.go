@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = &expandHostlistFunction{}
+	_ function.Function = &compactHostlistFunction{}
+)
+
+func NewExpandHostlistFunction() function.Function {
+	return &expandHostlistFunction{}
+}
+
+// expandHostlistFunction implements provider::pbs::expand_hostlist.
+type expandHostlistFunction struct{}
+
+func (f *expandHostlistFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "expand_hostlist"
+}
+
+func (f *expandHostlistFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Expand a hostlist expression into hostnames",
+		MarkdownDescription: DescFunctionExpandHostlist,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "hostlist",
+				MarkdownDescription: DescFunctionHostlistParameter,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *expandHostlistFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var hostlist string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &hostlist))
+	if resp.Error != nil {
+		return
+	}
+
+	hosts, err := expandHostlist(hostlist)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, hosts))
+}
+
+func NewCompactHostlistFunction() function.Function {
+	return &compactHostlistFunction{}
+}
+
+// compactHostlistFunction implements provider::pbs::compact_hostlist.
+type compactHostlistFunction struct{}
+
+func (f *compactHostlistFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "compact_hostlist"
+}
+
+func (f *compactHostlistFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Compact hostnames into a hostlist expression",
+		MarkdownDescription: DescFunctionCompactHostlist,
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "hosts",
+				MarkdownDescription: DescFunctionHostsParameter,
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *compactHostlistFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var hosts []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &hosts))
+	if resp.Error != nil {
+		return
+	}
+
+	hostlist, err := compactHostlist(hosts)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, hostlist))
+}
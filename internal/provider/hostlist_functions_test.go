@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccExpandHostlistFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig() + `
+output "hosts" {
+  value = join(",", provider::pbs::expand_hostlist("gpu[01-03,20],login01"))
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("hosts", "gpu01,gpu02,gpu03,gpu20,login01"),
+				),
+			},
+			{
+				Config: providerConfig() + `
+output "hosts" {
+  value = provider::pbs::expand_hostlist("gpu[03-01]")
+}
+`,
+				ExpectError: regexp.MustCompile(`reversed`),
+			},
+		},
+	})
+}
+
+func TestAccCompactHostlistFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig() + `
+output "hostlist" {
+  value = provider::pbs::compact_hostlist(["gpu02", "gpu01", "gpu03", "gpu20", "login01"])
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("hostlist", "gpu[01-03,20],login01"),
+				),
+			},
+		},
+	})
+}
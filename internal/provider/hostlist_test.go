@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestExpandHostlist(t *testing.T) {
+	tests := []struct {
+		expr string
+		want []string
+	}{
+		{"cn001", []string{"cn001"}},
+		{"cn[001-003]", []string{"cn001", "cn002", "cn003"}},
+		{"cn[8-11]", []string{"cn8", "cn9", "cn10", "cn11"}},
+		{"cn[1-2,5]", []string{"cn1", "cn2", "cn5"}},
+		{"cn[1-2],gpu01", []string{"cn1", "cn2", "gpu01"}},
+		{"rack[1-2]n[01-02]", []string{"rack1n01", "rack1n02", "rack2n01", "rack2n02"}},
+		{"node[1-2].example.com", []string{"node1.example.com", "node2.example.com"}},
+	}
+
+	for _, tt := range tests {
+		got, err := expandHostlist(tt.expr)
+		if err != nil {
+			t.Errorf("%s: unexpected error %s", tt.expr, err)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: got %q, wanted %q", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestExpandHostlistErrors(t *testing.T) {
+	for _, expr := range []string{
+		"cn[1-2",
+		"cn1-2]",
+		"cn[[1-2]]",
+		"cn[3-1]",
+		"cn[a-b]",
+		"cn[1-2],,gpu01",
+		"cn[1-1000000]",
+		"cn[1-90000,1-90000]",
+		"cn[1-3,0-9223372036854775807]",
+		"cn[0-9223372036854775807]",
+		"rack[1-99999]n[1-99999]",
+		"cn[1-60000],gpu[1-60000]",
+		"",
+	} {
+		if _, err := expandHostlist(expr); err == nil {
+			t.Errorf("%s: expected an error", expr)
+		}
+	}
+}
+
+func TestExpandHostlistRangeLimit(t *testing.T) {
+	_, err := expandHostlistRange("1-3,0-9223372036854775807", 10)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if want := "[1-3,0-9223372036854775807] expands to more than 10 hosts"; err.Error() != want {
+		t.Errorf("got %q, wanted %q", err.Error(), want)
+	}
+}
+
+func TestCompactHostlist(t *testing.T) {
+	tests := []struct {
+		hosts []string
+		want  string
+	}{
+		{[]string{"cn001"}, "cn001"},
+		{[]string{"cn003", "cn001", "cn002"}, "cn[001-003]"},
+		{[]string{"gpu01", "gpu02", "gpu03", "gpu20"}, "gpu[01-03,20]"},
+		{[]string{"cn8", "cn9", "cn10", "cn11"}, "cn[8-11]"},
+		{[]string{"cn099", "cn100"}, "cn[099-100]"},
+		{[]string{"n1", "n01"}, "n[01,1]"},
+		{[]string{"cn1", "cn1", "cn2"}, "cn[1-2]"},
+		{[]string{"login", "cn1", "cn2"}, "cn[1-2],login"},
+		{[]string{"rack1n01", "rack1n02", "rack2n01"}, "rack1n[01-02],rack2n01"},
+		{[]string{"node1.example.com", "node2.example.com"}, "node[1-2].example.com"},
+		{[]string{}, ""},
+	}
+
+	for _, tt := range tests {
+		got, err := compactHostlist(tt.hosts)
+		if err != nil {
+			t.Errorf("%q: unexpected error %s", tt.hosts, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%q: got %q, wanted %q", tt.hosts, got, tt.want)
+		}
+	}
+}
+
+func TestCompactHostlistRoundTrip(t *testing.T) {
+	hosts := []string{"cn8", "cn9", "cn10", "gpu01", "gpu02", "gpu20", "login01", "n1", "n01"}
+
+	compacted, err := compactHostlist(hosts)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	expanded, err := expandHostlist(compacted)
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	slices.Sort(hosts)
+	slices.Sort(expanded)
+	if !slices.Equal(expanded, hosts) {
+		t.Errorf("got %q, wanted %q", expanded, hosts)
+	}
+}
+
+func TestCompactHostlistErrors(t *testing.T) {
+	for _, hosts := range [][]string{
+		{"cn[1-2]"},
+		{"cn1,cn2"},
+		{""},
+	} {
+		if _, err := compactHostlist(hosts); err == nil {
+			t.Errorf("%q: expected an error", hosts)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-pbs/internal/pbsclient"
+)
+
+func NewPbsJobsDataSource() datasource.DataSource {
+	return &pbsJobsDataSource{}
+}
+
+type pbsJobsDataSource struct {
+	client *pbsclient.PbsClient
+}
+
+type pbsJobsDataSourceModel struct {
+	ID     types.String   `tfsdk:"id"`
+	Queue  types.String   `tfsdk:"queue"`
+	User   types.String   `tfsdk:"user"`
+	State  types.String   `tfsdk:"state"`
+	JobIDs []types.String `tfsdk:"job_ids"`
+	IDs    []types.String `tfsdk:"ids"`
+	Jobs   []pbsJobModel  `tfsdk:"jobs"`
+}
+
+type pbsJobModel struct {
+	ID                 types.String            `tfsdk:"id"`
+	Name               types.String            `tfsdk:"name"`
+	Owner              types.String            `tfsdk:"owner"`
+	Queue              types.String            `tfsdk:"queue"`
+	State              types.String            `tfsdk:"state"`
+	ResourcesRequested map[string]types.String `tfsdk:"resources_requested"`
+	ResourcesUsed      map[string]types.String `tfsdk:"resources_used"`
+	ExecHost           types.String            `tfsdk:"exec_host"`
+}
+
+// pbsJobFilter selects jobs for the pbs_jobs data source. Unset fields match every job.
+type pbsJobFilter struct {
+	queue *string
+	user  *string
+	state *string
+}
+
+// matches reports whether the job passes every filter. The user is compared without the submission host so
+// "alice" matches a job owned by "alice@login01".
+func (f pbsJobFilter) matches(j pbsclient.PbsJob) bool {
+	if f.queue != nil && j.Queue != *f.queue {
+		return false
+	}
+	if f.user != nil && j.User() != *f.user {
+		return false
+	}
+	if f.state != nil && j.State != *f.state {
+		return false
+	}
+
+	return true
+}
+
+func (d *pbsJobsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jobs"
+}
+
+func (d *pbsJobsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescJobsID,
+			},
+			"queue": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: DescJobsQueue,
+			},
+			"user": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: DescJobsUser,
+			},
+			"state": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: DescJobsState,
+				Validators: []validator.String{
+					stringvalidator.OneOf("B", "E", "F", "H", "M", "Q", "R", "S", "T", "U", "W", "X"),
+				},
+			},
+			"job_ids": schema.ListAttribute{
+				Optional:            true,
+				MarkdownDescription: DescJobsJobIDs,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"ids": schema.ListAttribute{
+				Computed:            true,
+				MarkdownDescription: DescJobsIDs,
+				ElementType:         types.StringType,
+			},
+			"jobs": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: DescJobsJobs,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescJobID,
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescJobName,
+						},
+						"owner": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescJobOwner,
+						},
+						"queue": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescJobQueue,
+						},
+						"state": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescJobState,
+						},
+						"resources_requested": schema.MapAttribute{
+							Computed:            true,
+							MarkdownDescription: DescJobResourcesRequested,
+							ElementType:         types.StringType,
+						},
+						"resources_used": schema.MapAttribute{
+							Computed:            true,
+							MarkdownDescription: DescJobResourcesUsed,
+							ElementType:         types.StringType,
+						},
+						"exec_host": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescJobExecHost,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *pbsJobsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	data := pbsJobsDataSourceModel{}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := pbsJobFilter{
+		queue: data.Queue.ValueStringPointer(),
+		user:  data.User.ValueStringPointer(),
+		state: data.State.ValueStringPointer(),
+	}
+
+	jobIDs := make([]string, 0, len(data.JobIDs))
+	for _, id := range data.JobIDs {
+		jobIDs = append(jobIDs, id.ValueString())
+	}
+
+	all, err := d.client.GetJobs(jobIDs)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to connect to PBS server and get job information", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue("jobs")
+	data.IDs = []types.String{}
+	data.Jobs = []pbsJobModel{}
+	for _, j := range all {
+		if !filter.matches(j) {
+			continue
+		}
+
+		data.IDs = append(data.IDs, types.StringValue(j.ID))
+		data.Jobs = append(data.Jobs, createPbsJobModel(j))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func createPbsJobModel(j pbsclient.PbsJob) pbsJobModel {
+	model := pbsJobModel{
+		ID:       types.StringValue(j.ID),
+		Name:     types.StringValue(j.Name),
+		Owner:    types.StringValue(j.Owner),
+		Queue:    types.StringValue(j.Queue),
+		State:    types.StringValue(j.State),
+		ExecHost: types.StringPointerValue(j.ExecHost),
+	}
+
+	if len(j.ResourcesRequested) > 0 {
+		model.ResourcesRequested = convertStringMapToTypesStringMap(j.ResourcesRequested)
+	}
+	if len(j.ResourcesUsed) > 0 {
+		model.ResourcesUsed = convertStringMapToTypesStringMap(j.ResourcesUsed)
+	}
+
+	return model
+}
+
+func (d *pbsJobsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pbsclient.PbsClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *PbsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
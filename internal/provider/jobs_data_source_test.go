@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-pbs/internal/pbsclient"
+)
+
+func TestPbsJobFilterMatches(t *testing.T) {
+	job := pbsclient.PbsJob{
+		ID:    "12.pbs",
+		Owner: "alice@login01",
+		Queue: "workq",
+		State: "R",
+	}
+
+	workq := "workq"
+	other := "gpu"
+	alice := "alice"
+	aliceAtHost := "alice@login01"
+	running := "R"
+	queued := "Q"
+	tests := []struct {
+		name   string
+		filter pbsJobFilter
+		want   bool
+	}{
+		{"no filter", pbsJobFilter{}, true},
+		{"queue", pbsJobFilter{queue: &workq}, true},
+		{"different queue", pbsJobFilter{queue: &other}, false},
+		{"user", pbsJobFilter{user: &alice}, true},
+		{"user with host", pbsJobFilter{user: &aliceAtHost}, false},
+		{"state", pbsJobFilter{state: &running}, true},
+		{"different state", pbsJobFilter{state: &queued}, false},
+		{"every filter", pbsJobFilter{queue: &workq, user: &alice, state: &running}, true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.filter.matches(job); got != tt.want {
+			t.Errorf("%s: got %t, wanted %t", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-pbs/internal/pbsclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &maintenanceReservationResource{}
+	_ resource.ResourceWithConfigure      = &maintenanceReservationResource{}
+	_ resource.ResourceWithImportState    = &maintenanceReservationResource{}
+	_ resource.ResourceWithValidateConfig = &maintenanceReservationResource{}
+)
+
+func NewMaintenanceReservationResource() resource.Resource {
+	return &maintenanceReservationResource{}
+}
+
+// maintenanceReservationResource manages a maintenance reservation, created with pbs_rsub --hosts. Unlike a user
+// reservation it takes whole hosts, is confirmed even if jobs are running on them and needs manager privileges.
+type maintenanceReservationResource struct {
+	client *pbsclient.PbsClient
+}
+
+type maintenanceReservationModel struct {
+	ID       types.String   `tfsdk:"id"`
+	Name     types.String   `tfsdk:"name"`
+	Hosts    []types.String `tfsdk:"hosts"`
+	Start    types.String   `tfsdk:"start"`
+	End      types.String   `tfsdk:"end"`
+	Duration types.String   `tfsdk:"duration"`
+	Nodes    types.List     `tfsdk:"nodes"`
+	State    types.String   `tfsdk:"state"`
+}
+
+// toReservationModel returns the fields shared with a user reservation so that times can be converted and
+// preserved in the same way.
+func (m maintenanceReservationModel) toReservationModel() reservationModel {
+	return reservationModel{
+		ID:       m.ID,
+		Name:     m.Name,
+		Start:    m.Start,
+		End:      m.End,
+		Duration: m.Duration,
+	}
+}
+
+func (r *maintenanceReservationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenance_reservation"
+}
+
+func (r *maintenanceReservationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescMaintenanceReservationID,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: DescReservationName,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hosts": schema.ListAttribute{
+				Required:            true,
+				MarkdownDescription: DescMaintenanceReservationHosts,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"start": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: DescMaintenanceReservationStart,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"end": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: DescReservationEnd,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"duration": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: DescReservationDuration,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"nodes": schema.ListAttribute{
+				Computed:            true,
+				MarkdownDescription: DescMaintenanceReservationNodes,
+				ElementType:         types.StringType,
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescReservationState,
+			},
+		},
+	}
+}
+
+func (r *maintenanceReservationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pbsclient.PbsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pbsclient.PbsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *maintenanceReservationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data maintenanceReservationModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateReservationTimes(data.Start, data.End, data.Duration)...)
+}
+
+func (r *maintenanceReservationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model maintenanceReservationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, err := model.toReservationModel().ToPbsReservation()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Reservation", err.Error())
+		return
+	}
+	desired.Hosts, err = expandPoolHosts(model.Hosts)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("hosts"), "Invalid Hosts", err.Error())
+		return
+	}
+
+	id, err := r.client.CreateReservation(desired)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating resource", fmt.Sprintf("Could not create maintenance reservation, unexpected error: %s", err))
+		return
+	}
+
+	reservation, found, err := waitForReservationConfirmation(ctx, r.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read maintenance reservation %s, got error: %s", id, err))
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Reservation Denied", fmt.Sprintf("Maintenance reservation %s was denied by the server.", id))
+		return
+	}
+	resp.Diagnostics.Append(unconfirmedReservationWarning(reservation)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createMaintenanceReservationModel(reservation, model))...)
+}
+
+func (r *maintenanceReservationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state maintenanceReservationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reservation, found, err := r.client.GetReservation(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read maintenance reservation %s, got error: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	// If the reservation has been deleted outside of terraform, or has finished, remove it from the state
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createMaintenanceReservationModel(reservation, state))...)
+}
+
+func (r *maintenanceReservationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute requires replacement so there is nothing to do on the server
+	var model maintenanceReservationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *maintenanceReservationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data maintenanceReservationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteReservation(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete maintenance reservation %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+func (r *maintenanceReservationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the standard passthrough for ID, which will set both id and trigger a Read
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// createMaintenanceReservationModel creates the model from a reservation read from the server. The hosts are kept
+// as configured so that hostlist expressions are left alone, on import they are taken from the reserved nodes.
+func createMaintenanceReservationModel(r pbsclient.PbsReservation, prior maintenanceReservationModel) maintenanceReservationModel {
+	shared := createReservationModel(r, prior.toReservationModel())
+
+	model := maintenanceReservationModel{
+		ID:       shared.ID,
+		Name:     shared.Name,
+		Hosts:    prior.Hosts,
+		Start:    shared.Start,
+		End:      shared.End,
+		Duration: shared.Duration,
+		State:    shared.State,
+	}
+
+	names := r.NodeNames()
+	nodes := make([]attr.Value, 0, len(names))
+	for _, name := range names {
+		nodes = append(nodes, types.StringValue(name))
+	}
+	model.Nodes = types.ListValueMust(types.StringType, nodes)
+
+	if len(model.Hosts) == 0 {
+		for _, name := range names {
+			model.Hosts = append(model.Hosts, types.StringValue(name))
+		}
+	}
+
+	return model
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMaintenanceReservationResource_basic(t *testing.T) {
+	start := time.Now().UTC().Add(48 * time.Hour).Truncate(time.Hour)
+	startText := start.Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMaintenanceReservationResourceConfig(startText, "01:00:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("pbs_maintenance_reservation.test", "id", regexp.MustCompile(`^M\d+\.`)),
+					resource.TestCheckResourceAttr("pbs_maintenance_reservation.test", "state", "RESV_CONFIRMED"),
+					resource.TestCheckResourceAttr("pbs_maintenance_reservation.test", "nodes.#", "1"),
+					resource.TestCheckResourceAttr("pbs_maintenance_reservation.test", "nodes.0", "pbs"),
+					resource.TestCheckResourceAttr("pbs_maintenance_reservation.test", "end", start.Add(time.Hour).Format(time.RFC3339)),
+				),
+			},
+			{
+				ResourceName:            "pbs_maintenance_reservation.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"state"},
+			},
+			// The window can't be altered in place so a longer window is a new reservation
+			{
+				Config: testAccMaintenanceReservationResourceConfig(startText, "02:00:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_maintenance_reservation.test", "end", start.Add(2*time.Hour).Format(time.RFC3339)),
+				),
+			},
+		},
+	})
+}
+
+func testAccMaintenanceReservationResourceConfig(start string, duration string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_maintenance_reservation" "test" {
+  name     = "firmware"
+  hosts    = ["pbs"]
+  start    = %[1]q
+  duration = %[2]q
+}
+`, start, duration)
+}
@@ -0,0 +1,517 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"terraform-provider-pbs/internal/pbsclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/ssh"
+)
+
+var (
+	_ resource.Resource                = &momConfigResource{}
+	_ resource.ResourceWithConfigure   = &momConfigResource{}
+	_ resource.ResourceWithImportState = &momConfigResource{}
+)
+
+var momUserRegex = regexp.MustCompile(`^[^,\s'"]+$`)
+
+// momConfigManagedOptions are the directives with their own attribute which can't also be set through options.
+var momConfigManagedOptions = []string{"clienthost", "restrict_user", "restrict_user_exceptions", "restrict_user_maxsysid", "usecp", "ideal_load", "max_load"}
+
+func NewMomConfigResource() resource.Resource {
+	return &momConfigResource{}
+}
+
+// momConfigResource manages directives in mom_priv/config on an execution host. The provider only talks to the
+// server host, so this connects to the MoM's host directly. Only the directives that are set are changed, every
+// other line including static resources and comments is left as it was.
+type momConfigResource struct {
+	client *pbsclient.PbsClient
+}
+
+type momConfigModel struct {
+	ID                     types.String            `tfsdk:"id"`
+	Node                   types.String            `tfsdk:"node"`
+	Host                   types.String            `tfsdk:"host"`
+	Path                   types.String            `tfsdk:"path"`
+	Connection             *sshConnectionModel     `tfsdk:"connection"`
+	ClientHosts            []types.String          `tfsdk:"clienthost"`
+	RestrictUser           types.Bool              `tfsdk:"restrict_user"`
+	RestrictUserExceptions []types.String          `tfsdk:"restrict_user_exceptions"`
+	RestrictUserMaxSysID   types.Int32             `tfsdk:"restrict_user_maxsysid"`
+	UseCp                  []types.String          `tfsdk:"usecp"`
+	IdealLoad              types.Float64           `tfsdk:"ideal_load"`
+	MaxLoad                types.Float64           `tfsdk:"max_load"`
+	Options                map[string]types.String `tfsdk:"options"`
+}
+
+type sshConnectionModel struct {
+	Port          types.String `tfsdk:"port"`
+	Username      types.String `tfsdk:"username"`
+	Password      types.String `tfsdk:"password"`
+	SshPrivateKey types.String `tfsdk:"ssh_private_key"`
+}
+
+func (r *momConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mom_config"
+}
+
+func (r *momConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	listOfValues := []validator.List{
+		listvalidator.ValueStringsAre(stringvalidator.RegexMatches(schedConfigValueRegex, "must be a single line without double quotes")),
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescMomConfigID,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"node": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: DescMomConfigNode,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: DescMomConfigHost,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(pbsclient.DefaultMomConfigPath),
+				MarkdownDescription: DescMomConfigPath,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(absolutePathRegex, "must be an absolute path"),
+				},
+			},
+			"connection": sshConnectionSchema(),
+			"clienthost": schema.ListAttribute{
+				Optional:            true,
+				MarkdownDescription: DescMomConfigClientHost,
+				ElementType:         types.StringType,
+				Validators:          listOfValues,
+			},
+			"restrict_user": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: DescMomConfigRestrictUser,
+			},
+			"restrict_user_exceptions": schema.ListAttribute{
+				Optional:            true,
+				MarkdownDescription: DescMomConfigRestrictUserExceptions,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(momUserRegex, "must be a user name")),
+				},
+			},
+			"restrict_user_maxsysid": schema.Int32Attribute{
+				Optional:            true,
+				MarkdownDescription: DescMomConfigRestrictUserMaxSysID,
+			},
+			"usecp": schema.ListAttribute{
+				Optional:            true,
+				MarkdownDescription: DescMomConfigUseCp,
+				ElementType:         types.StringType,
+				Validators:          listOfValues,
+			},
+			"ideal_load": schema.Float64Attribute{
+				Optional:            true,
+				MarkdownDescription: DescMomConfigIdealLoad,
+			},
+			"max_load": schema.Float64Attribute{
+				Optional:            true,
+				MarkdownDescription: DescMomConfigMaxLoad,
+			},
+			"options": schema.MapAttribute{
+				Optional:            true,
+				MarkdownDescription: DescMomConfigOptions,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(schedConfigTokenRegex, "must be a directive name without the $"),
+						stringvalidator.NoneOf(momConfigManagedOptions...),
+					),
+					mapvalidator.ValueStringsAre(stringvalidator.RegexMatches(schedConfigValueRegex, "must be a single line without double quotes")),
+				},
+			},
+		},
+	}
+}
+
+func (r *momConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pbsclient.PbsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pbsclient.PbsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *momConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model momConfigModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.apply(model, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating resource", fmt.Sprintf("Could not update the MoM configuration of %s, unexpected error: %s", model.Node.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+}
+
+func (r *momConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state momConfigModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// For import, the ID is the name of the node
+	if state.Node.IsNull() {
+		state.Node = state.ID
+	}
+	if state.Path.IsNull() {
+		state.Path = types.StringValue(pbsclient.DefaultMomConfigPath)
+	}
+
+	mom, err := momHostClient(r.client, state.Node, &state.Host, state.Connection)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to connect to the MoM of %s, got error: %s", state.Node.ValueString(), err))
+		return
+	}
+
+	config, err := mom.GetMomConfig(state.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s on %s, got error: %s", state.Path.ValueString(), state.Host.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createMomConfigModel(config, state))...)
+}
+
+func (r *momConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan momConfigModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.apply(plan, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the MoM configuration. "+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+}
+
+func (r *momConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// As with sched_config the directives are left as they are rather than reverting to pbs_mom's defaults
+	resp.Diagnostics.AddWarning(
+		"MoM Configuration Not Changed",
+		"The MoM configuration has been removed from Terraform state but mom_priv/config is unchanged.",
+	)
+}
+
+func (r *momConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the standard passthrough for ID, which will set both id and trigger a Read
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// sshConnectionSchema is the connection attribute of resources which can connect to a host other than the server.
+func sshConnectionSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: DescSshConnection,
+		Attributes: map[string]schema.Attribute{
+			"port": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: DescSshConnectionPort,
+			},
+			"username": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: DescSshConnectionUsername,
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: DescSshConnectionPassword,
+			},
+			"ssh_private_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: DescSshConnectionSshPrivateKey,
+			},
+		},
+	}
+}
+
+// momHostClient returns a client connected to the MoM of node, setting host from the node's Mom attribute if it
+// isn't known.
+func momHostClient(client *pbsclient.PbsClient, nodeName types.String, host *types.String, connection *sshConnectionModel) (*pbsclient.PbsClient, error) {
+	if host.IsNull() || host.IsUnknown() {
+		node, err := client.GetNode(nodeName.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		if node.Name == "" {
+			return nil, fmt.Errorf("node %s does not exist, set host to connect to its MoM", nodeName.ValueString())
+		}
+
+		*host = types.StringValue(node.Name)
+		if node.Mom != nil && *node.Mom != "" {
+			*host = types.StringValue(*node.Mom)
+		}
+	}
+
+	return sshHostClient(client, host.ValueString(), connection)
+}
+
+// sshHostClient returns a client connected to host, or the server if host is empty. The provider's SSH port and
+// credentials are used unless connection overrides them.
+func sshHostClient(client *pbsclient.PbsClient, host string, connection *sshConnectionModel) (*pbsclient.PbsClient, error) {
+	if host == "" && connection == nil {
+		return client, nil
+	}
+
+	port := ""
+	var sshConfig *ssh.ClientConfig
+	if c := connection; c != nil {
+		port = c.Port.ValueString()
+
+		username := client.SshClientConfig.User
+		if !c.Username.IsNull() {
+			username = c.Username.ValueString()
+		}
+
+		if c.Password.ValueString() != "" || c.SshPrivateKey.ValueString() != "" {
+			var err error
+			sshConfig, err = newSshClientConfig(username, c.Password.ValueString(), c.SshPrivateKey.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("cannot parse SSH private key: %v", err)
+			}
+		} else if username != client.SshClientConfig.User {
+			// Same credentials as the provider as a different user
+			sshConfig = &ssh.ClientConfig{
+				User:            username,
+				Auth:            client.SshClientConfig.Auth,
+				HostKeyCallback: client.SshClientConfig.HostKeyCallback,
+			}
+		}
+	}
+
+	return client.ForHost(host, port, sshConfig), nil
+}
+
+// apply writes the configured directives to the MoM's config file and reloads pbs_mom if anything changed. A MoM
+// that isn't running to reload is reported as a warning in diags.
+func (r *momConfigResource) apply(model momConfigModel, diags *diag.Diagnostics) (momConfigModel, error) {
+	mom, err := momHostClient(r.client, model.Node, &model.Host, model.Connection)
+	if err != nil {
+		return model, err
+	}
+
+	config, err := mom.EditMomConfig(model.Path.ValueString(), func(config *pbsclient.MomConfig) {
+		applyMomConfigModel(config, model)
+	})
+	if err = warnIfMomNotRunning(diags, err); err != nil {
+		return model, err
+	}
+
+	return createMomConfigModel(config, model), nil
+}
+
+// warnIfMomNotRunning adds a warning rather than an error when a MoM file was changed but pbs_mom isn't running to
+// re-read it. Any other error is returned unchanged.
+func warnIfMomNotRunning(diags *diag.Diagnostics, err error) error {
+	if !errors.Is(err, pbsclient.ErrMomNotRunning) {
+		return err
+	}
+
+	diags.AddWarning("MoM Not Running", "The file was updated but pbs_mom isn't running to re-read it, the change takes effect when pbs_mom is next started.")
+	return nil
+}
+
+// applyMomConfigModel sets every directive which has a value in the model. Directives which aren't set are left
+// alone.
+func applyMomConfigModel(config *pbsclient.MomConfig, model momConfigModel) {
+	if model.ClientHosts != nil {
+		config.Set("$clienthost", stringValues(model.ClientHosts))
+	}
+	if !model.RestrictUser.IsNull() {
+		value := "False"
+		if model.RestrictUser.ValueBool() {
+			value = "True"
+		}
+		config.Set("$restrict_user", []string{value})
+	}
+	if model.RestrictUserExceptions != nil {
+		config.Set("$restrict_user_exceptions", []string{strings.Join(stringValues(model.RestrictUserExceptions), ",")})
+	}
+	if !model.RestrictUserMaxSysID.IsNull() {
+		config.Set("$restrict_user_maxsysid", []string{strconv.Itoa(int(model.RestrictUserMaxSysID.ValueInt32()))})
+	}
+	if model.UseCp != nil {
+		config.Set("$usecp", stringValues(model.UseCp))
+	}
+	if !model.IdealLoad.IsNull() {
+		config.Set("$ideal_load", []string{strconv.FormatFloat(model.IdealLoad.ValueFloat64(), 'f', -1, 64)})
+	}
+	if !model.MaxLoad.IsNull() {
+		config.Set("$max_load", []string{strconv.FormatFloat(model.MaxLoad.ValueFloat64(), 'f', -1, 64)})
+	}
+	for directive, value := range model.Options {
+		config.Set("$"+directive, []string{value.ValueString()})
+	}
+}
+
+// createMomConfigModel reads the directives managed by prior from the file. Directives that prior doesn't set are
+// left null so that the rest of the file isn't taken over, as are ones which can't be read as the attribute's type.
+func createMomConfigModel(config pbsclient.MomConfig, prior momConfigModel) momConfigModel {
+	model := momConfigModel{
+		ID:                   prior.Node,
+		Node:                 prior.Node,
+		Host:                 prior.Host,
+		Path:                 prior.Path,
+		Connection:           prior.Connection,
+		RestrictUser:         types.BoolNull(),
+		RestrictUserMaxSysID: types.Int32Null(),
+		IdealLoad:            types.Float64Null(),
+		MaxLoad:              types.Float64Null(),
+	}
+
+	single := func(directive string) (string, bool) {
+		values := config.Values(directive)
+		if len(values) != 1 {
+			return "", false
+		}
+		return values[0], true
+	}
+
+	if prior.ClientHosts != nil {
+		model.ClientHosts = toStringValues(config.Values("$clienthost"))
+	}
+	if !prior.RestrictUser.IsNull() {
+		if v, ok := single("$restrict_user"); ok {
+			if b, err := strconv.ParseBool(v); err == nil {
+				model.RestrictUser = types.BoolValue(b)
+			}
+		}
+	}
+	if prior.RestrictUserExceptions != nil {
+		if v, ok := single("$restrict_user_exceptions"); ok {
+			model.RestrictUserExceptions = []types.String{}
+			for _, user := range strings.Split(v, ",") {
+				if user = strings.TrimSpace(user); user != "" {
+					model.RestrictUserExceptions = append(model.RestrictUserExceptions, types.StringValue(user))
+				}
+			}
+		}
+	}
+	if !prior.RestrictUserMaxSysID.IsNull() {
+		if v, ok := single("$restrict_user_maxsysid"); ok {
+			if n, err := strconv.ParseInt(v, 10, 32); err == nil {
+				model.RestrictUserMaxSysID = types.Int32Value(int32(n))
+			}
+		}
+	}
+	if prior.UseCp != nil {
+		model.UseCp = toStringValues(config.Values("$usecp"))
+	}
+	if !prior.IdealLoad.IsNull() {
+		if v, ok := single("$ideal_load"); ok {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				model.IdealLoad = types.Float64Value(f)
+			}
+		}
+	}
+	if !prior.MaxLoad.IsNull() {
+		if v, ok := single("$max_load"); ok {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				model.MaxLoad = types.Float64Value(f)
+			}
+		}
+	}
+	if prior.Options != nil {
+		model.Options = map[string]types.String{}
+		for directive := range prior.Options {
+			if v, ok := single("$" + directive); ok {
+				model.Options[directive] = types.StringValue(v)
+			}
+		}
+	}
+
+	return model
+}
+
+// stringValues returns the values of a list of strings.
+func stringValues(values []types.String) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		result = append(result, v.ValueString())
+	}
+
+	return result
+}
+
+// toStringValues returns strings as a list of string values.
+func toStringValues(values []string) []types.String {
+	result := make([]types.String, 0, len(values))
+	for _, v := range values {
+		result = append(result, types.StringValue(v))
+	}
+
+	return result
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"fmt"
+	"terraform-provider-pbs/internal/pbsclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testMomConfig = `$clienthost pbs
+$clienthost pbs2
+$restrict_user_maxsysid 999
+# static resources
+ngpus 4
+$usecp *:/home /home
+$logevent 0x1ff
+`
+
+func TestApplyMomConfigModel(t *testing.T) {
+	config := pbsclient.ParseMomConfig(testMomConfig)
+	applyMomConfigModel(&config, momConfigModel{
+		ClientHosts:            []types.String{types.StringValue("pbs")},
+		RestrictUser:           types.BoolValue(true),
+		RestrictUserExceptions: []types.String{types.StringValue("root"), types.StringValue("admin")},
+		UseCp:                  []types.String{},
+		IdealLoad:              types.Float64Value(1.5),
+		Options:                map[string]types.String{"logevent": types.StringValue("0xff")},
+	})
+
+	wanted := `$clienthost pbs
+$restrict_user_maxsysid 999
+# static resources
+ngpus 4
+$logevent 0xff
+$restrict_user True
+$restrict_user_exceptions root,admin
+$ideal_load 1.5
+`
+	if got := config.String(); got != wanted {
+		t.Errorf("got %q, wanted %q", got, wanted)
+	}
+}
+
+func TestCreateMomConfigModel(t *testing.T) {
+	config := pbsclient.ParseMomConfig(testMomConfig + "$restrict_user true\n$max_load high\n")
+	prior := momConfigModel{
+		Node:                 types.StringValue("node01"),
+		Path:                 types.StringValue(pbsclient.DefaultMomConfigPath),
+		ClientHosts:          []types.String{},
+		RestrictUser:         types.BoolValue(false),
+		RestrictUserMaxSysID: types.Int32Value(500),
+		MaxLoad:              types.Float64Value(2),
+		Options: map[string]types.String{
+			"logevent":        types.StringValue("0xff"),
+			"checkpoint_path": types.StringValue("/tmp"),
+		},
+	}
+
+	model := createMomConfigModel(config, prior)
+
+	if got := len(model.ClientHosts); got != 2 {
+		t.Fatalf("got %d client hosts, wanted 2", got)
+	}
+	if got := model.ClientHosts[1].ValueString(); got != "pbs2" {
+		t.Errorf("got %q, wanted %q", got, "pbs2")
+	}
+	if !model.RestrictUser.ValueBool() {
+		t.Errorf("got %s, wanted true restrict_user", model.RestrictUser)
+	}
+	if got := model.RestrictUserMaxSysID.ValueInt32(); got != 999 {
+		t.Errorf("got %d, wanted %d", got, 999)
+	}
+	// max_load isn't a number so can't be read
+	if !model.MaxLoad.IsNull() {
+		t.Errorf("got %s, wanted null max_load", model.MaxLoad)
+	}
+	if model.UseCp != nil {
+		t.Errorf("got %v, wanted usecp to be unmanaged", model.UseCp)
+	}
+	if got := model.Options["logevent"].ValueString(); got != "0x1ff" {
+		t.Errorf("got %q, wanted %q", got, "0x1ff")
+	}
+	if _, ok := model.Options["checkpoint_path"]; ok {
+		t.Errorf("got checkpoint_path, wanted it to be missing")
+	}
+	if got := model.ID.ValueString(); got != "node01" {
+		t.Errorf("got %q, wanted %q", got, "node01")
+	}
+}
+
+func TestAccMomConfigResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMomConfigResourceConfig("0x1ff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_mom_config.test", "id", "pbs"),
+					resource.TestCheckResourceAttr("pbs_mom_config.test", "host", "pbs"),
+					resource.TestCheckResourceAttr("pbs_mom_config.test", "clienthost.#", "1"),
+					resource.TestCheckResourceAttr("pbs_mom_config.test", "options.logevent", "0x1ff"),
+				),
+			},
+			{
+				Config: testAccMomConfigResourceConfig("0xff"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_mom_config.test", "options.logevent", "0xff"),
+				),
+			},
+			{
+				ResourceName:            "pbs_mom_config.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"clienthost", "options"},
+			},
+		},
+	})
+}
+
+func testAccMomConfigResourceConfig(logevent string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_mom_config" "test" {
+  node       = "pbs"
+  clienthost = ["pbs"]
+
+  options = {
+    logevent = %[1]q
+  }
+}
+`, logevent)
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNodeAttributeResource_basic(t *testing.T) {
+	nodeName := getTestNodeName("attribute")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckNodeDestroy,
+		Steps: []resource.TestStep{
+			// The node ignores the resource owned by pbs_node_attribute so the plan stays empty
+			{
+				Config: testAccNodeAttributeResourceConfig(nodeName, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_node_attribute.ngpus", "id", nodeName+"/resources_available.ngpus"),
+					resource.TestCheckResourceAttr("pbs_node_attribute.ngpus", "value", "2"),
+					resource.TestCheckResourceAttr("data.pbs_node.test", "resources_available.ngpus", "2"),
+					resource.TestCheckNoResourceAttr("pbs_node.test", "resources_available.ngpus"),
+				),
+			},
+			{
+				ResourceName:      "pbs_node_attribute.ngpus",
+				ImportState:       true,
+				ImportStateId:     nodeName + "/resources_available.ngpus",
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNodeAttributeResourceConfig(nodeName, "4"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pbs_node.test", "resources_available.ngpus", "4"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNodeAttributeResourceConfig(nodeName string, ngpus string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_node" "test" {
+  name              = %[1]q
+  resv_enable       = true
+  ignore_attributes = ["resources_available.ngpus"]
+}
+
+resource "pbs_node_attribute" "ngpus" {
+  node     = pbs_node.test.name
+  name     = "resources_available"
+  resource = "ngpus"
+  value    = %[2]q
+}
+
+data "pbs_node" "test" {
+  name       = pbs_node.test.name
+  depends_on = [pbs_node_attribute.ngpus]
+}
+`, nodeName, ngpus)
+}
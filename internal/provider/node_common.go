@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"slices"
 	"terraform-provider-pbs/internal/pbsclient"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -27,6 +28,53 @@ type pbsNodeModel struct {
 	ResvEnable         types.Bool              `tfsdk:"resv_enable"`
 }
 
+// pbsNodeResourceModel extends the node model shared with the data source with resource only settings.
+type pbsNodeResourceModel struct {
+	pbsNodeModel
+	IgnoreAttributes []types.String `tfsdk:"ignore_attributes"`
+	Offline          types.Bool     `tfsdk:"offline"`
+	OfflineComment   types.String   `tfsdk:"offline_comment"`
+	DrainOnDestroy   types.Bool     `tfsdk:"drain_on_destroy"`
+	DrainTimeout     types.Int32    `tfsdk:"drain_timeout"`
+	RequeueOnTimeout types.Bool     `tfsdk:"requeue_on_drain_timeout"`
+}
+
+// ignoredAttributes returns the attributes that the node resource leaves alone. The comment is owned by
+// offline_comment when that is set because pbsnodes -C records the reason in the node comment.
+func (m pbsNodeResourceModel) ignoredAttributes() []types.String {
+	if m.OfflineComment.IsNull() {
+		return m.IgnoreAttributes
+	}
+	return append(slices.Clone(m.IgnoreAttributes), types.StringValue("comment"))
+}
+
+// createPbsNodeResourceModel builds the resource model from the node returned by PBS. The prior model is the
+// plan or state the node was read for, it provides the values of ignored attributes and decides whether the
+// offline state is managed at all.
+func createPbsNodeResourceModel(h pbsclient.PbsNode, prior pbsNodeResourceModel) pbsNodeResourceModel {
+	model := pbsNodeResourceModel{
+		pbsNodeModel:     createPbsNodeModel(h),
+		IgnoreAttributes: prior.IgnoreAttributes,
+		Offline:          prior.Offline,
+		OfflineComment:   prior.OfflineComment,
+		DrainOnDestroy:   prior.DrainOnDestroy,
+		DrainTimeout:     prior.DrainTimeout,
+		RequeueOnTimeout: prior.RequeueOnTimeout,
+	}
+
+	// Attributes managed elsewhere never show up as drift
+	preserveIgnoredAttributes(prior.ignoredAttributes(), &prior.pbsNodeModel, &model.pbsNodeModel)
+
+	if !prior.Offline.IsNull() {
+		model.Offline = types.BoolValue(h.IsOffline())
+	}
+	if !prior.OfflineComment.IsNull() && h.IsOffline() && h.Comment != nil {
+		model.OfflineComment = types.StringValue(*h.Comment)
+	}
+
+	return model
+}
+
 func (m pbsNodeModel) ToPbsNode() pbsclient.PbsNode {
 	node := pbsclient.PbsNode{
 		Name: m.Name.ValueString(),
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"fmt"
+	"terraform-provider-pbs/internal/pbsclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type nodePoolModel struct {
+	ID                 types.String                     `tfsdk:"id"`
+	Name               types.String                     `tfsdk:"name"`
+	Hosts              []types.String                   `tfsdk:"hosts"`
+	Queue              types.String                     `tfsdk:"queue"`
+	Partition          types.String                     `tfsdk:"partition"`
+	Priority           types.Int32                      `tfsdk:"priority"`
+	ResourcesAvailable map[string]types.String          `tfsdk:"resources_available"`
+	Overrides          map[string]nodePoolOverrideModel `tfsdk:"overrides"`
+	Nodes              types.List                       `tfsdk:"nodes"`
+}
+
+type nodePoolOverrideModel struct {
+	Queue              types.String            `tfsdk:"queue"`
+	Partition          types.String            `tfsdk:"partition"`
+	Priority           types.Int32             `tfsdk:"priority"`
+	ResourcesAvailable map[string]types.String `tfsdk:"resources_available"`
+}
+
+// expandPoolHosts expands every hostname or hostlist pattern into the individual hostnames, in order and with
+// duplicates removed.
+func expandPoolHosts(hosts []types.String) ([]string, error) {
+	names := []string{}
+	seen := map[string]bool{}
+	for _, h := range hosts {
+		expanded, err := expandHostlist(h.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range expanded {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+
+	return names, nil
+}
+
+// ToPbsNodes returns the desired state of every node in the pool. Overrides replace the shared queue,
+// partition and priority and are merged over the shared resources_available.
+func (m nodePoolModel) ToPbsNodes() ([]pbsclient.PbsNode, error) {
+	names, err := expandPoolHosts(m.Hosts)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make(map[string]bool, len(names))
+	for _, name := range names {
+		members[name] = true
+	}
+	for name := range m.Overrides {
+		if !members[name] {
+			return nil, fmt.Errorf("override for %s which is not in the pool", name)
+		}
+	}
+
+	nodes := make([]pbsclient.PbsNode, 0, len(names))
+	for _, name := range names {
+		node := pbsclient.PbsNode{Name: name}
+		SetStringPointerIfNotNull(m.Queue, &node.Queue)
+		SetStringPointerIfNotNull(m.Partition, &node.Partition)
+		SetInt32PointerIfNotNull(m.Priority, &node.Priority)
+		ConvertTypesStringMapIfNotEmpty(m.ResourcesAvailable, &node.ResourcesAvailable)
+
+		if o, ok := m.Overrides[name]; ok {
+			SetStringPointerIfNotNull(o.Queue, &node.Queue)
+			SetStringPointerIfNotNull(o.Partition, &node.Partition)
+			SetInt32PointerIfNotNull(o.Priority, &node.Priority)
+			if len(o.ResourcesAvailable) > 0 {
+				if node.ResourcesAvailable == nil {
+					node.ResourcesAvailable = map[string]string{}
+				}
+				for k, v := range o.ResourcesAvailable {
+					node.ResourcesAvailable[k] = v.ValueString()
+				}
+			}
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	return nodes, nil
+}
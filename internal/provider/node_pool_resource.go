@@ -0,0 +1,322 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-pbs/internal/pbsclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource               = &nodePoolResource{}
+	_ resource.ResourceWithConfigure  = &nodePoolResource{}
+	_ resource.ResourceWithModifyPlan = &nodePoolResource{}
+)
+
+func NewNodePoolResource() resource.Resource {
+	return &nodePoolResource{}
+}
+
+// nodePoolResource manages a set of identically configured nodes generated from hostlist patterns. The whole
+// pool is reconciled against a single list node read with batched qmgr directives so that large clusters
+// don't need one resource (and one SSH round trip) per node.
+type nodePoolResource struct {
+	client *pbsclient.PbsClient
+}
+
+func (r *nodePoolResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node_pool"
+}
+
+func (r *nodePoolResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescNodePoolID,
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: DescNodePoolName,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hosts": schema.ListAttribute{
+				Required:            true,
+				MarkdownDescription: DescNodePoolHosts,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"queue": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: DescNodePoolQueue,
+			},
+			"partition": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: DescNodePoolPartition,
+			},
+			"priority": schema.Int32Attribute{
+				Optional:            true,
+				MarkdownDescription: DescNodePoolPriority,
+			},
+			"resources_available": schema.MapAttribute{
+				Optional:            true,
+				MarkdownDescription: DescNodePoolResourcesAvailable,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.NoneOf("host", "vnode")),
+				},
+			},
+			"overrides": schema.MapNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: DescNodePoolOverrides,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"queue": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: DescNodePoolQueue,
+						},
+						"partition": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: DescNodePoolPartition,
+						},
+						"priority": schema.Int32Attribute{
+							Optional:            true,
+							MarkdownDescription: DescNodePoolPriority,
+						},
+						"resources_available": schema.MapAttribute{
+							Optional:            true,
+							MarkdownDescription: DescNodePoolResourcesAvailable,
+							ElementType:         types.StringType,
+							Validators: []validator.Map{
+								mapvalidator.KeysAre(stringvalidator.NoneOf("host", "vnode")),
+							},
+						},
+					},
+				},
+			},
+			"nodes": schema.ListAttribute{
+				Computed:            true,
+				MarkdownDescription: DescNodePoolNodes,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *nodePoolResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pbsclient.PbsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pbsclient.PbsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan plans nodes as the full expansion of hosts. Read only lists the nodes which exist and match the
+// pool, so any missing or drifted node shows up as a diff on nodes and is fixed by the next apply.
+func (r *nodePoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var name types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !name.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), name)...)
+	}
+
+	// Leave nodes unknown until the hosts and overrides are fully known
+	var hosts types.List
+	var overrides types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("hosts"), &hosts)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("overrides"), &overrides)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, v := range []attr.Value{hosts, overrides} {
+		if raw, err := v.ToTerraformValue(ctx); err != nil || !raw.IsFullyKnown() {
+			return
+		}
+	}
+
+	var plan nodePoolModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, err := plan.ToPbsNodes()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Node Pool", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(r.setPoolNodes(ctx, &plan, desired)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("nodes"), plan.Nodes)...)
+}
+
+func (r *nodePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model nodePoolModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, err := model.ToPbsNodes()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Node Pool", err.Error())
+		return
+	}
+
+	err = r.client.ReconcileNodePool(nil, desired)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating resource", fmt.Sprintf("Could not create node pool %s, unexpected error: %s", model.Name.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.setPoolNodes(ctx, &model, desired)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *nodePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state nodePoolModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, err := state.ToPbsNodes()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Node Pool", err.Error())
+		return
+	}
+
+	existing, err := r.client.GetNodePoolMembers(desired)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read node pool %s, got error: %s", state.Name.ValueString(), err))
+		return
+	}
+
+	// If every node has been removed outside of terraform, remove the pool from the state
+	if len(existing) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Only report the nodes which exist and are in sync, anything else is planned as a change
+	inSync := []pbsclient.PbsNode{}
+	for _, d := range desired {
+		if current, ok := existing[d.Name]; ok && pbsclient.NodeMatchesPool(current, d) {
+			inSync = append(inSync, d)
+		}
+	}
+
+	resp.Diagnostics.Append(r.setPoolNodes(ctx, &state, inSync)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *nodePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state nodePoolModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previous, err := state.ToPbsNodes()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Node Pool", err.Error())
+		return
+	}
+	desired, err := plan.ToPbsNodes()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Node Pool", err.Error())
+		return
+	}
+
+	err = r.client.ReconcileNodePool(previous, desired)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the node pool. "+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.setPoolNodes(ctx, &plan, desired)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *nodePoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data nodePoolModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names, err := expandPoolHosts(data.Hosts)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Node Pool", err.Error())
+		return
+	}
+
+	err = r.client.DeleteNodePool(names)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete node pool %s, got error: %s", data.Name.ValueString(), err))
+		return
+	}
+}
+
+// setPoolNodes sets the computed id and nodes attributes from the given pool members.
+func (r *nodePoolResource) setPoolNodes(ctx context.Context, model *nodePoolModel, members []pbsclient.PbsNode) diag.Diagnostics {
+	names := make([]string, 0, len(members))
+	for _, m := range members {
+		names = append(names, m.Name)
+	}
+
+	nodes, diags := types.ListValueFrom(ctx, types.StringType, names)
+	model.ID = model.Name
+	model.Nodes = nodes
+
+	return diags
+}
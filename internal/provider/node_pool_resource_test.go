@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNodePoolResource_basic(t *testing.T) {
+	poolName := testAccResourceName("pool")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNodePoolResourceConfig(poolName, "compute[1-2]", "10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_node_pool.test", "id", poolName),
+					resource.TestCheckResourceAttr("pbs_node_pool.test", "nodes.#", "2"),
+					resource.TestCheckResourceAttr("pbs_node_pool.test", "nodes.0", "compute1"),
+					resource.TestCheckResourceAttr("pbs_node_pool.test", "nodes.1", "compute2"),
+					resource.TestCheckResourceAttr("data.pbs_node.compute1", "priority", "10"),
+					resource.TestCheckResourceAttr("data.pbs_node.compute1", "resources_available.ngpus", "2"),
+					resource.TestCheckResourceAttr("data.pbs_node.compute2", "priority", "50"),
+					resource.TestCheckResourceAttr("data.pbs_node.compute2", "resources_available.ngpus", "4"),
+				),
+			},
+			// Growing the pool creates the new node and updates the shared attributes in one apply
+			{
+				Config: testAccNodePoolResourceConfig(poolName, "compute[1-3]", "20"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_node_pool.test", "nodes.#", "3"),
+					resource.TestCheckResourceAttr("pbs_node_pool.test", "nodes.2", "compute3"),
+					resource.TestCheckResourceAttr("data.pbs_node.compute1", "priority", "20"),
+					resource.TestCheckResourceAttr("data.pbs_node.compute2", "priority", "50"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNodePoolResource_invalidOverride(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig() + `
+resource "pbs_node_pool" "test" {
+  name  = "invalid"
+  hosts = ["compute[1-2]"]
+
+  overrides = {
+    compute3 = {
+      priority = 1
+    }
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`override for compute3 which is not in the pool`),
+			},
+		},
+	})
+}
+
+func testAccNodePoolResourceConfig(poolName string, hosts string, priority string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_node_pool" "test" {
+  name     = %[1]q
+  hosts    = [%[2]q]
+  priority = %[3]s
+
+  resources_available = {
+    ngpus = "2"
+  }
+
+  overrides = {
+    compute2 = {
+      priority = 50
+      resources_available = {
+        ngpus = "4"
+      }
+    }
+  }
+}
+
+data "pbs_node" "compute1" {
+  name       = "compute1"
+  depends_on = [pbs_node_pool.test]
+}
+
+data "pbs_node" "compute2" {
+  name       = "compute2"
+  depends_on = [pbs_node_pool.test]
+}
+`, poolName, hosts, priority)
+}
@@ -3,12 +3,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 	"terraform-provider-pbs/internal/pbsclient"
 	validators "terraform-provider-pbs/internal/provider/validators"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,6 +21,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultNodeDrainTimeout = time.Hour
+	nodeRequeueTimeout      = 2 * time.Minute
+	nodeDrainPollInterval   = 15 * time.Second
 )
 
 var (
@@ -44,6 +55,14 @@ func (r *pbsNodeResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Computed:            true,
 				MarkdownDescription: DescNodeID,
 			},
+			"ignore_attributes": schema.SetAttribute{
+				Optional:            true,
+				MarkdownDescription: DescIgnoreAttributes,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(newIgnoreAttributesValidator(pbsNodeModel{})),
+				},
+			},
 			"comment": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: DescNodeComment,
@@ -153,6 +172,32 @@ func (r *pbsNodeResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Required:            true,
 				MarkdownDescription: DescNodeResvEnable,
 			},
+			"offline": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: DescNodeOffline,
+			},
+			"offline_comment": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: DescNodeOfflineComment,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("comment")),
+				},
+			},
+			"drain_on_destroy": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: DescNodeDrainOnDestroy,
+			},
+			"drain_timeout": schema.Int32Attribute{
+				Optional:            true,
+				MarkdownDescription: DescNodeDrainTimeout,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"requeue_on_drain_timeout": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: DescNodeRequeueOnDrainTimeout,
+			},
 		},
 	}
 }
@@ -177,7 +222,7 @@ func (r *pbsNodeResource) Configure(_ context.Context, req resource.ConfigureReq
 }
 
 func (r *pbsNodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var model pbsNodeModel
+	var model pbsNodeResourceModel
 	var pbsNode pbsclient.PbsNode
 	diags := req.Plan.Get(ctx, &model)
 	resp.Diagnostics.Append(diags...)
@@ -191,9 +236,20 @@ func (r *pbsNodeResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	model = createPbsNodeModel(pbsNode)
+	if r.reconcileOfflineState(model, pbsNode, &resp.Diagnostics) {
+		pbsNode, err = r.client.GetNode(pbsNode.Name)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read node, got error: %s", err))
+			return
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resultModel := createPbsNodeResourceModel(pbsNode, model)
 
-	diags = resp.State.Set(ctx, model)
+	diags = resp.State.Set(ctx, resultModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -201,7 +257,7 @@ func (r *pbsNodeResource) Create(ctx context.Context, req resource.CreateRequest
 }
 
 func (r *pbsNodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data pbsNodeModel
+	var data pbsNodeResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
@@ -227,17 +283,28 @@ func (r *pbsNodeResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	rModel := createPbsNodeModel(pbsNode)
+	rModel := createPbsNodeResourceModel(pbsNode, data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &rModel)...)
 }
 
 func (r *pbsNodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data pbsNodeModel
+	var data pbsNodeResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-	updatedNode, err := r.client.UpdateNode(data.ToPbsNode())
+	current, err := r.client.GetNode(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read node, got error: %s", err))
+		return
+	}
+
+	// Attributes managed elsewhere keep whatever value they currently have on the server
+	desired := data.pbsNodeModel
+	currentModel := createPbsNodeModel(current)
+	preserveIgnoredAttributes(data.ignoredAttributes(), &currentModel, &desired)
+
+	updatedNode, err := r.client.UpdateNode(desired.ToPbsNode())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Update Resource",
@@ -249,15 +316,26 @@ func (r *pbsNodeResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	if r.reconcileOfflineState(data, updatedNode, &resp.Diagnostics) {
+		updatedNode, err = r.client.GetNode(updatedNode.Name)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read node, got error: %s", err))
+			return
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create the model from the updated node to ensure all fields including ID are properly set
-	updatedModel := createPbsNodeModel(updatedNode)
+	updatedModel := createPbsNodeResourceModel(updatedNode, data)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedModel)...)
 }
 
 func (r *pbsNodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data pbsNodeModel
+	var data pbsNodeResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
@@ -265,6 +343,13 @@ func (r *pbsNodeResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	if data.DrainOnDestroy.ValueBool() {
+		if err := r.drainNode(ctx, data); err != nil {
+			resp.Diagnostics.AddError("Unable to Drain Node", fmt.Sprintf("Node %s was not deleted and has been left offline: %s", data.Name.ValueString(), err))
+			return
+		}
+	}
+
 	err := r.client.DeleteNode(data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete node, got error: %s", err))
@@ -272,6 +357,165 @@ func (r *pbsNodeResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
+// reconcileOfflineState marks the node offline or returns it to service with pbsnodes so that it matches the
+// plan. Nothing is done when offline isn't configured. Returns true if the node was changed.
+func (r *pbsNodeResource) reconcileOfflineState(plan pbsNodeResourceModel, current pbsclient.PbsNode, diags *diag.Diagnostics) bool {
+	if plan.Offline.IsNull() {
+		return false
+	}
+
+	if plan.Offline.ValueBool() {
+		commentChanged := !plan.OfflineComment.IsNull() && (current.Comment == nil || *current.Comment != plan.OfflineComment.ValueString())
+		if current.IsOffline() && !commentChanged {
+			return false
+		}
+
+		err := r.client.SetNodeOffline(current.Name, plan.OfflineComment.ValueStringPointer())
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to mark node %s offline, got error: %s", current.Name, err))
+			return false
+		}
+
+		if jobs := current.RunningJobs(); len(jobs) > 0 && !current.IsOffline() {
+			diags.AddWarning(
+				"Node Has Running Jobs",
+				fmt.Sprintf("Node %s has been marked offline but the following jobs are still running on it: %s. "+
+					"No new jobs will be started on the node, the running jobs are left to finish.", current.Name, strings.Join(jobs, ", ")),
+			)
+		}
+
+		return true
+	}
+
+	if !current.IsOffline() {
+		return false
+	}
+
+	// The comment only needs clearing when it was set from offline_comment
+	err := r.client.ClearNodeOffline(current.Name, !plan.OfflineComment.IsNull())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to return node %s to service, got error: %s", current.Name, err))
+		return false
+	}
+
+	return true
+}
+
+// drainNode marks the node offline and waits for the jobs running on it to finish so that it can be deleted
+// without orphaning work. Jobs still running after the drain timeout are requeued with qrerun if
+// requeue_on_drain_timeout is set, otherwise an error is returned.
+func (r *pbsNodeResource) drainNode(ctx context.Context, data pbsNodeResourceModel) error {
+	name := data.Name.ValueString()
+	timeout := defaultNodeDrainTimeout
+	if !data.DrainTimeout.IsNull() {
+		timeout = time.Duration(data.DrainTimeout.ValueInt32()) * time.Second
+	}
+
+	node, err := r.client.GetNode(name)
+	if err != nil {
+		return err
+	}
+	if node.Name == "" {
+		return nil
+	}
+
+	if !node.IsOffline() {
+		tflog.Info(ctx, "Marking node offline before deletion", map[string]any{"node": name})
+		if err := r.client.SetNodeOffline(name, nil); err != nil {
+			return err
+		}
+	}
+
+	drainer := nodeDrainer{
+		name:           name,
+		pollInterval:   nodeDrainPollInterval,
+		requeueTimeout: nodeRequeueTimeout,
+		runningJobs: func() ([]string, error) {
+			node, err := r.client.GetNode(name)
+			if err != nil {
+				return nil, err
+			}
+			return node.RunningJobs(), nil
+		},
+		rerunJobs: r.client.RerunJobs,
+	}
+
+	return drainer.drain(ctx, timeout, data.RequeueOnTimeout.ValueBool())
+}
+
+// nodeDrainer decides when a node that has been marked offline is empty, requeueing the jobs still running on
+// it if asked to. The node is only accessed through runningJobs and rerunJobs.
+type nodeDrainer struct {
+	name           string
+	pollInterval   time.Duration
+	requeueTimeout time.Duration
+	runningJobs    func() ([]string, error)
+	rerunJobs      func(jobIDs []string) error
+}
+
+// drain waits up to timeout for the running jobs to finish. Jobs still running after that are requeued if
+// requeue is set, otherwise an error is returned.
+func (d nodeDrainer) drain(ctx context.Context, timeout time.Duration, requeue bool) error {
+	jobs, err := d.wait(ctx, timeout)
+	if err != nil {
+		return err
+	}
+	if len(jobs) == 0 {
+		return nil
+	}
+
+	if !requeue {
+		return fmt.Errorf("timed out after %s waiting for jobs to finish: %s", timeout, strings.Join(jobs, ", "))
+	}
+
+	tflog.Warn(ctx, "Requeueing jobs still running on node after drain timeout", map[string]any{"node": d.name, "jobs": jobs})
+	if err := d.rerunJobs(jobs); err != nil {
+		return fmt.Errorf("unable to requeue jobs %s: %w", strings.Join(jobs, ", "), err)
+	}
+
+	jobs, err = d.wait(ctx, d.requeueTimeout)
+	if err != nil {
+		return err
+	}
+	if len(jobs) > 0 {
+		return fmt.Errorf("jobs still running after being requeued: %s", strings.Join(jobs, ", "))
+	}
+
+	return nil
+}
+
+// wait polls the node until no jobs are running on it or the timeout elapses, returning the jobs that are
+// still running.
+func (d nodeDrainer) wait(ctx context.Context, timeout time.Duration) ([]string, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		jobs, err := d.runningJobs()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(jobs) == 0 {
+			tflog.Info(ctx, "Node has no running jobs", map[string]any{"node": d.name})
+			return nil, nil
+		}
+		if !time.Now().Before(deadline) {
+			return jobs, nil
+		}
+
+		tflog.Info(ctx, "Waiting for jobs to finish on node", map[string]any{
+			"node":      d.name,
+			"jobs":      jobs,
+			"remaining": time.Until(deadline).Round(time.Second).String(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return jobs, ctx.Err()
+		case <-time.After(d.pollInterval):
+		}
+	}
+}
+
 func (r *pbsNodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Use the standard passthrough for ID, which will set both id and trigger a Read
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
@@ -1,8 +1,13 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -10,7 +15,7 @@ import (
 
 // Available node names that correspond to Docker containers.
 var availableTestNodes = []string{
-	"compute1", "compute2", "compute3", "node1", "node2",
+	"compute1", "compute2", "compute3", "compute4", "compute5", "node1", "node2",
 }
 
 // getTestNodeName returns a specific node name for each test to avoid conflicts.
@@ -21,6 +26,9 @@ func getTestNodeName(testName string) string {
 		"powerAndProvisioning": "compute3",
 		"comprehensive":        "node1",
 		"minimal":              "node2",
+		"attribute":            "compute4",
+		"offline":              "compute3",
+		"drain":                "compute5",
 	}
 
 	if nodeName, exists := testNodeMap[testName]; exists {
@@ -235,6 +243,66 @@ func TestAccNodeResource_minimalSupported(t *testing.T) {
 	})
 }
 
+// TestAccNodeResource_offline tests taking a node offline for maintenance and returning it to service.
+func TestAccNodeResource_offline(t *testing.T) {
+	nodeName := getTestNodeName("offline")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckNodeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNodeResourceConfigOffline(nodeName, true, "disk replacement"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNodeExists("pbs_node.test"),
+					resource.TestCheckResourceAttr("pbs_node.test", "offline", "true"),
+					resource.TestCheckResourceAttr("pbs_node.test", "offline_comment", "disk replacement"),
+					resource.TestCheckNoResourceAttr("pbs_node.test", "comment"),
+					resource.TestCheckResourceAttr("data.pbs_node.test", "comment", "disk replacement"),
+				),
+			},
+			// Changing the reason keeps the node offline
+			{
+				Config: testAccNodeResourceConfigOffline(nodeName, true, "bios update"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_node.test", "offline", "true"),
+					resource.TestCheckResourceAttr("data.pbs_node.test", "comment", "bios update"),
+				),
+			},
+			{
+				Config: testAccNodeResourceConfigOffline(nodeName, false, "bios update"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_node.test", "offline", "false"),
+					resource.TestCheckNoResourceAttr("data.pbs_node.test", "comment"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccNodeResource_drainOnDestroy tests that an idle node is drained and deleted on destroy.
+func TestAccNodeResource_drainOnDestroy(t *testing.T) {
+	nodeName := getTestNodeName("drain")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckNodeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNodeResourceConfigDrain(nodeName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNodeExists("pbs_node.test"),
+					resource.TestCheckResourceAttr("pbs_node.test", "drain_on_destroy", "true"),
+					resource.TestCheckResourceAttr("pbs_node.test", "drain_timeout", "30"),
+					resource.TestCheckResourceAttr("pbs_node.test", "requeue_on_drain_timeout", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNodeExists(resourceName string) resource.TestCheckFunc { //nolint:unparam
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -388,3 +456,112 @@ resource "pbs_node" "test" {
 }
 `, name)
 }
+
+func testAccNodeResourceConfigOffline(name string, offline bool, reason string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_node" "test" {
+  name            = %[1]q
+  resv_enable     = true
+  offline         = %[2]t
+  offline_comment = %[3]q
+}
+
+data "pbs_node" "test" {
+  name       = pbs_node.test.name
+  depends_on = [pbs_node.test]
+}
+`, name, offline, reason)
+}
+
+func testAccNodeResourceConfigDrain(name string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_node" "test" {
+  name                     = %[1]q
+  resv_enable              = true
+  drain_on_destroy         = true
+  drain_timeout            = 30
+  requeue_on_drain_timeout = true
+}
+`, name)
+}
+
+// TestNodeDrainer runs the drain decision logic against a fake node whose jobs finish after a number of polls.
+func TestNodeDrainer(t *testing.T) {
+	tests := []struct {
+		name       string
+		polls      int // polls before the jobs finish, -1 if they never do
+		rerunPolls int // polls before requeued jobs leave the node, -1 if they never do
+		requeue    bool
+		rerunErr   error
+		wantErr    string
+		wantRerun  bool
+	}{
+		{name: "idle", polls: 0},
+		{name: "finished", polls: 2},
+		{name: "timeout", polls: -1, wantErr: "timed out"},
+		{name: "requeued", polls: -1, rerunPolls: 1, requeue: true, wantRerun: true},
+		{name: "requeue failed", polls: -1, requeue: true, rerunErr: errors.New("qrerun: not rerunable"), wantErr: "unable to requeue", wantRerun: true},
+		{name: "still running", polls: -1, rerunPolls: -1, requeue: true, wantErr: "still running after being requeued", wantRerun: true},
+	}
+
+	for _, tt := range tests {
+		polls := 0
+		var rerun []string
+		drainer := nodeDrainer{
+			name:           "node01",
+			pollInterval:   time.Millisecond,
+			requeueTimeout: 20 * time.Millisecond,
+			runningJobs: func() ([]string, error) {
+				polls++
+				remaining := tt.polls
+				if rerun != nil {
+					remaining = tt.rerunPolls
+				}
+				if remaining >= 0 && polls > remaining {
+					return nil, nil
+				}
+				return []string{"12.pbs", "13.pbs"}, nil
+			},
+			rerunJobs: func(jobIDs []string) error {
+				rerun = jobIDs
+				polls = 0
+				return tt.rerunErr
+			},
+		}
+
+		err := drainer.drain(context.Background(), 20*time.Millisecond, tt.requeue)
+		if tt.wantErr == "" && err != nil {
+			t.Errorf("%s: got error %v, wanted none", tt.name, err)
+		}
+		if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+			t.Errorf("%s: got error %v, wanted %q", tt.name, err, tt.wantErr)
+		}
+		if tt.wantRerun != (rerun != nil) {
+			t.Errorf("%s: got requeued jobs %v, wanted requeue %t", tt.name, rerun, tt.wantRerun)
+		}
+		if rerun != nil && !slices.Equal(rerun, []string{"12.pbs", "13.pbs"}) {
+			t.Errorf("%s: got requeued jobs %v, wanted both running jobs", tt.name, rerun)
+		}
+	}
+}
+
+func TestNodeDrainerCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	drainer := nodeDrainer{
+		name:         "node01",
+		pollInterval: time.Hour,
+		runningJobs: func() ([]string, error) {
+			return []string{"12.pbs"}, nil
+		},
+		rerunJobs: func(jobIDs []string) error {
+			t.Errorf("got requeued jobs %v after cancellation", jobIDs)
+			return nil
+		},
+	}
+
+	if err := drainer.drain(ctx, time.Hour, true); !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, wanted %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-pbs/internal/pbsclient"
+)
+
+func NewPbsNodesDataSource() datasource.DataSource {
+	return &pbsNodesDataSource{}
+}
+
+type pbsNodesDataSource struct {
+	client *pbsclient.PbsClient
+}
+
+type pbsNodesDataSourceModel struct {
+	ID                 types.String            `tfsdk:"id"`
+	State              types.String            `tfsdk:"state"`
+	Queue              types.String            `tfsdk:"queue"`
+	Partition          types.String            `tfsdk:"partition"`
+	NameRegex          types.String            `tfsdk:"name_regex"`
+	ResourcesAvailable map[string]types.String `tfsdk:"resources_available"`
+	Names              []types.String          `tfsdk:"names"`
+	Nodes              []pbsNodesEntryModel    `tfsdk:"nodes"`
+}
+
+type pbsNodesEntryModel struct {
+	Name               types.String            `tfsdk:"name"`
+	Mom                types.String            `tfsdk:"mom"`
+	Port               types.Int32             `tfsdk:"port"`
+	State              types.String            `tfsdk:"state"`
+	Comment            types.String            `tfsdk:"comment"`
+	Queue              types.String            `tfsdk:"queue"`
+	Partition          types.String            `tfsdk:"partition"`
+	Priority           types.Int32             `tfsdk:"priority"`
+	ResvEnable         types.Bool              `tfsdk:"resv_enable"`
+	ResourcesAvailable map[string]types.String `tfsdk:"resources_available"`
+	Jobs               []types.String          `tfsdk:"jobs"`
+}
+
+// pbsNodeFilter selects nodes for the pbs_nodes data source. Unset fields match every node.
+type pbsNodeFilter struct {
+	state              *string
+	queue              *string
+	partition          *string
+	nameRegex          *regexp.Regexp
+	resourcesAvailable map[string]string
+}
+
+// matches reports whether the node passes every filter. A node matches a state if it is any one of the node's
+// states, so "offline" matches a node that is "job-busy,offline". Resource values are compared case
+// insensitively so that booleans match however they are written.
+func (f pbsNodeFilter) matches(n pbsclient.PbsNode) bool {
+	if f.state != nil && !slices.Contains(n.States(), *f.state) {
+		return false
+	}
+	if f.queue != nil && (n.Queue == nil || *n.Queue != *f.queue) {
+		return false
+	}
+	if f.partition != nil && (n.Partition == nil || *n.Partition != *f.partition) {
+		return false
+	}
+	if f.nameRegex != nil && !f.nameRegex.MatchString(n.Name) {
+		return false
+	}
+	for k, v := range f.resourcesAvailable {
+		actual, ok := n.ResourcesAvailable[k]
+		if !ok || !strings.EqualFold(actual, v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (d *pbsNodesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nodes"
+}
+
+func (d *pbsNodesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescNodesID,
+			},
+			"state": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: DescNodesState,
+			},
+			"queue": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: DescNodesQueue,
+			},
+			"partition": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: DescNodesPartition,
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: DescNodesNameRegex,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"resources_available": schema.MapAttribute{
+				Optional:            true,
+				MarkdownDescription: DescNodesResourcesAvailable,
+				ElementType:         types.StringType,
+			},
+			"names": schema.ListAttribute{
+				Computed:            true,
+				MarkdownDescription: DescNodesNames,
+				ElementType:         types.StringType,
+			},
+			"nodes": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: DescNodesNodes,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescNodeName,
+						},
+						"mom": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescNodeMom,
+						},
+						"port": schema.Int32Attribute{
+							Computed:            true,
+							MarkdownDescription: DescNodePort,
+						},
+						"state": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescNodesNodeState,
+						},
+						"comment": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescNodeComment,
+						},
+						"queue": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescNodeQueue,
+						},
+						"partition": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescNodePartition,
+						},
+						"priority": schema.Int32Attribute{
+							Computed:            true,
+							MarkdownDescription: DescNodePriority,
+						},
+						"resv_enable": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: DescNodeResvEnable,
+						},
+						"resources_available": schema.MapAttribute{
+							Computed:            true,
+							MarkdownDescription: DescNodeResourcesAvailable,
+							ElementType:         types.StringType,
+						},
+						"jobs": schema.ListAttribute{
+							Computed:            true,
+							MarkdownDescription: DescNodesNodeJobs,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *pbsNodesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	data := pbsNodesDataSourceModel{}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := pbsNodeFilter{
+		state:     data.State.ValueStringPointer(),
+		queue:     data.Queue.ValueStringPointer(),
+		partition: data.Partition.ValueStringPointer(),
+	}
+	if !data.NameRegex.IsNull() {
+		re, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return
+		}
+		filter.nameRegex = re
+	}
+	ConvertTypesStringMapIfNotEmpty(data.ResourcesAvailable, &filter.resourcesAvailable)
+
+	all, err := d.client.GetNodes()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to connect to PBS server and get node information", err.Error())
+		return
+	}
+
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+
+	data.ID = types.StringValue("nodes")
+	data.Names = []types.String{}
+	data.Nodes = []pbsNodesEntryModel{}
+	for _, n := range all {
+		if !filter.matches(n) {
+			continue
+		}
+
+		data.Names = append(data.Names, types.StringValue(n.Name))
+		data.Nodes = append(data.Nodes, createPbsNodesEntryModel(n))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func createPbsNodesEntryModel(n pbsclient.PbsNode) pbsNodesEntryModel {
+	entry := pbsNodesEntryModel{
+		Name:       types.StringValue(n.Name),
+		Mom:        types.StringPointerValue(n.Mom),
+		Port:       types.Int32PointerValue(n.Port),
+		State:      types.StringPointerValue(n.State),
+		Comment:    types.StringPointerValue(n.Comment),
+		Queue:      types.StringPointerValue(n.Queue),
+		Partition:  types.StringPointerValue(n.Partition),
+		Priority:   types.Int32PointerValue(n.Priority),
+		ResvEnable: types.BoolPointerValue(n.ResvEnable),
+		Jobs:       []types.String{},
+	}
+
+	if len(n.ResourcesAvailable) > 0 {
+		entry.ResourcesAvailable = convertStringMapToTypesStringMap(n.ResourcesAvailable)
+	}
+	for _, job := range n.RunningJobs() {
+		entry.Jobs = append(entry.Jobs, types.StringValue(job))
+	}
+
+	return entry
+}
+
+func (d *pbsNodesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pbsclient.PbsClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *PbsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"terraform-provider-pbs/internal/pbsclient"
+)
+
+func TestPbsNodeFilterMatches(t *testing.T) {
+	state := "job-busy,offline"
+	partition := "a"
+	node := pbsclient.PbsNode{
+		Name:               "gpu01",
+		State:              &state,
+		Partition:          &partition,
+		ResourcesAvailable: map[string]string{"ngpus": "4", "has_ib": "True"},
+	}
+
+	offline := "offline"
+	free := "free"
+	other := "b"
+	tests := []struct {
+		name   string
+		filter pbsNodeFilter
+		want   bool
+	}{
+		{"no filter", pbsNodeFilter{}, true},
+		{"one of the states", pbsNodeFilter{state: &offline}, true},
+		{"different state", pbsNodeFilter{state: &free}, false},
+		{"partition", pbsNodeFilter{partition: &partition}, true},
+		{"different partition", pbsNodeFilter{partition: &other}, false},
+		{"unset queue", pbsNodeFilter{queue: &other}, false},
+		{"name regex", pbsNodeFilter{nameRegex: regexp.MustCompile(`^gpu`)}, true},
+		{"different name", pbsNodeFilter{nameRegex: regexp.MustCompile(`^cpu`)}, false},
+		{"resources", pbsNodeFilter{resourcesAvailable: map[string]string{"ngpus": "4", "has_ib": "true"}}, true},
+		{"different resource", pbsNodeFilter{resourcesAvailable: map[string]string{"ngpus": "8"}}, false},
+		{"missing resource", pbsNodeFilter{resourcesAvailable: map[string]string{"mem": "1gb"}}, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.filter.matches(node); got != tt.want {
+			t.Errorf("%s: got %t, wanted %t", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"terraform-provider-pbs/internal/pbsclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &pbsConfResource{}
+	_ resource.ResourceWithConfigure   = &pbsConfResource{}
+	_ resource.ResourceWithImportState = &pbsConfResource{}
+)
+
+var (
+	pbsConfKeyRegex = regexp.MustCompile(`^PBS_[A-Z0-9_]+$`)
+	// pbs.conf is sourced by the init script so values can't contain anything the shell would interpret
+	pbsConfValueRegex = regexp.MustCompile("^[^\\s\"'`$;&|<>\\\\]*$")
+)
+
+func NewPbsConfResource() resource.Resource {
+	return &pbsConfResource{}
+}
+
+// pbsConfResource manages keys in pbs.conf on the server or another host in the complex. Only the keys that are set
+// are changed, every other line is left as it was.
+type pbsConfResource struct {
+	client *pbsclient.PbsClient
+}
+
+type pbsConfModel struct {
+	ID              types.String            `tfsdk:"id"`
+	Host            types.String            `tfsdk:"host"`
+	Path            types.String            `tfsdk:"path"`
+	Connection      *sshConnectionModel     `tfsdk:"connection"`
+	Settings        map[string]types.String `tfsdk:"settings"`
+	RestartRequired types.Bool              `tfsdk:"restart_required"`
+}
+
+// pbsConfID is the path of the file, prefixed with the host and a colon when it isn't on the server.
+func pbsConfID(host types.String, filePath types.String) types.String {
+	if host.IsNull() {
+		return filePath
+	}
+	return types.StringValue(host.ValueString() + ":" + filePath.ValueString())
+}
+
+// createPbsConfSettings reads the keys in prior from the file, keys which aren't set in the file are left out.
+func createPbsConfSettings(conf pbsclient.PbsConf, prior map[string]types.String) map[string]types.String {
+	if prior == nil {
+		return nil
+	}
+
+	settings := map[string]types.String{}
+	for key := range prior {
+		if value, ok := conf.Get(key); ok {
+			settings[key] = types.StringValue(value)
+		}
+	}
+
+	return settings
+}
+
+func (r *pbsConfResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_conf"
+}
+
+func (r *pbsConfResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescPbsConfID,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"host": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: DescPbsConfHost,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(pbsclient.DefaultPbsConfPath),
+				MarkdownDescription: DescPbsConfPath,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(absolutePathRegex, "must be an absolute path"),
+				},
+			},
+			"connection": sshConnectionSchema(),
+			"settings": schema.MapAttribute{
+				Required:            true,
+				MarkdownDescription: DescPbsConfSettings,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(pbsConfKeyRegex, "must be a pbs.conf key such as PBS_SERVER")),
+					mapvalidator.ValueStringsAre(stringvalidator.RegexMatches(pbsConfValueRegex, "must not contain whitespace, quotes or shell metacharacters")),
+				},
+			},
+			"restart_required": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: DescPbsConfRestartRequired,
+			},
+		},
+	}
+}
+
+func (r *pbsConfResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pbsclient.PbsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pbsclient.PbsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *pbsConfResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model pbsConfModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.apply(model)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating resource", fmt.Sprintf("Could not update %s, unexpected error: %s", model.Path.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+}
+
+func (r *pbsConfResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state pbsConfModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// For import, the ID is the path of the file optionally prefixed with the host
+	if state.Path.IsNull() {
+		host, filePath, found := strings.Cut(state.ID.ValueString(), ":")
+		if found {
+			state.Host = types.StringValue(host)
+			state.Path = types.StringValue(filePath)
+		} else {
+			state.Path = state.ID
+		}
+		state.RestartRequired = types.BoolValue(false)
+	}
+
+	client, err := sshHostClient(r.client, state.Host.ValueString(), state.Connection)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to connect to %s, got error: %s", state.Host.ValueString(), err))
+		return
+	}
+
+	conf, err := client.GetPbsConf(state.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s, got error: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	state.ID = pbsConfID(state.Host, state.Path)
+	state.Settings = createPbsConfSettings(conf, state.Settings)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *pbsConfResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan pbsConfModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.apply(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update pbs.conf. "+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+}
+
+func (r *pbsConfResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Removing keys such as PBS_SERVER would stop PBS from starting so the file is left as it is
+	resp.Diagnostics.AddWarning(
+		"pbs.conf Not Changed",
+		"The pbs.conf settings have been removed from Terraform state but the file is unchanged.",
+	)
+}
+
+func (r *pbsConfResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the standard passthrough for ID, which will set both id and trigger a Read
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply writes the configured keys to the file and records whether that changed it.
+func (r *pbsConfResource) apply(model pbsConfModel) (pbsConfModel, error) {
+	client, err := sshHostClient(r.client, model.Host.ValueString(), model.Connection)
+	if err != nil {
+		return model, err
+	}
+
+	conf, changed, err := client.EditPbsConf(model.Path.ValueString(), func(conf *pbsclient.PbsConf) {
+		for key, value := range model.Settings {
+			conf.Set(key, value.ValueString())
+		}
+	})
+	if err != nil {
+		return model, err
+	}
+
+	model.ID = pbsConfID(model.Host, model.Path)
+	model.Settings = createPbsConfSettings(conf, model.Settings)
+	model.RestartRequired = types.BoolValue(changed)
+
+	return model, nil
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"terraform-provider-pbs/internal/pbsclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestCreatePbsConfSettings(t *testing.T) {
+	conf := pbsclient.ParsePbsConf("PBS_SERVER=pbs\nPBS_START_MOM=1\n")
+
+	settings := createPbsConfSettings(conf, map[string]types.String{
+		"PBS_START_MOM":    types.StringValue("0"),
+		"PBS_LEAF_ROUTERS": types.StringValue("comm1"),
+	})
+	if got := len(settings); got != 1 {
+		t.Fatalf("got %d settings, wanted 1", got)
+	}
+	if got := settings["PBS_START_MOM"].ValueString(); got != "1" {
+		t.Errorf("got %q, wanted %q", got, "1")
+	}
+
+	if got := createPbsConfSettings(conf, nil); got != nil {
+		t.Errorf("got %v, wanted no settings when none are managed", got)
+	}
+}
+
+func TestPbsConfID(t *testing.T) {
+	tests := []struct {
+		host string
+		want string
+	}{
+		{"", "/etc/pbs.conf"},
+		{"node01", "node01:/etc/pbs.conf"},
+	}
+
+	for _, tt := range tests {
+		host := types.StringNull()
+		if tt.host != "" {
+			host = types.StringValue(tt.host)
+		}
+		if got := pbsConfID(host, types.StringValue("/etc/pbs.conf")).ValueString(); got != tt.want {
+			t.Errorf("got %q, wanted %q", got, tt.want)
+		}
+	}
+}
+
+func TestAccPbsConfResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPbsConfResourceConfig("unlimited"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_conf.test", "id", pbsclient.DefaultPbsConfPath),
+					resource.TestCheckResourceAttr("pbs_conf.test", "settings.PBS_CORE_LIMIT", "unlimited"),
+				),
+			},
+			{
+				Config: testAccPbsConfResourceConfig("0"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_conf.test", "settings.PBS_CORE_LIMIT", "0"),
+					resource.TestCheckResourceAttr("pbs_conf.test", "restart_required", "true"),
+				),
+			},
+			// Leave the file as it was
+			{
+				Config: testAccPbsConfResourceConfig("unlimited"),
+			},
+		},
+	})
+}
+
+func testAccPbsConfResourceConfig(coreLimit string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_conf" "test" {
+  settings = {
+    PBS_CORE_LIMIT = %[1]q
+  }
+}
+`, coreLimit)
+}
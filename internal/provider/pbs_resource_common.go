@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"slices"
 	"terraform-provider-pbs/internal/pbsclient"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -35,3 +36,37 @@ func createPbsResoureModel(r pbsclient.PbsResource) pbsResourceModel {
 
 	return model
 }
+
+// pbsResourceResourceModel is the resource's model, which can also manage whether the scheduler checks the resource.
+type pbsResourceResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Type            types.String `tfsdk:"type"`
+	Flag            types.String `tfsdk:"flag"`
+	Schedulable     types.Bool   `tfsdk:"schedulable"`
+	SchedConfigPath types.String `tfsdk:"sched_config_path"`
+}
+
+func (m pbsResourceResourceModel) ToPbsResource() pbsclient.PbsResource {
+	return pbsResourceModel{ID: m.ID, Name: m.Name, Type: m.Type, Flag: m.Flag}.ToPbsResource()
+}
+
+// createPbsResourceResourceModel creates the model from the resource and the scheduler configuration. schedulable
+// is only read when it is managed, i.e. not null in prior.
+func createPbsResourceResourceModel(r pbsclient.PbsResource, config pbsclient.SchedConfig, prior types.Bool) pbsResourceResourceModel {
+	common := createPbsResoureModel(r)
+	model := pbsResourceResourceModel{
+		ID:          common.ID,
+		Name:        common.Name,
+		Type:        common.Type,
+		Flag:        common.Flag,
+		Schedulable: types.BoolNull(),
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		names := config.Resources()
+		model.Schedulable = types.BoolValue(names == nil || slices.Contains(names, r.Name))
+	}
+
+	return model
+}
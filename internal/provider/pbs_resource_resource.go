@@ -8,10 +8,13 @@ import (
 	validators "terraform-provider-pbs/internal/provider/validators"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
@@ -61,6 +64,19 @@ func (r *pbsResourceResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Optional:            true,
 				// TODO - Validators for the flags when I understand them better
 			},
+			"schedulable": schema.BoolAttribute{
+				MarkdownDescription: DescPbsResourceSchedulable,
+				Optional:            true,
+			},
+			"sched_config_path": schema.StringAttribute{
+				MarkdownDescription: DescPbsResourceSchedConfigPath,
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(pbsclient.DefaultSchedConfigPath),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(absolutePathRegex, "must be an absolute path"),
+				},
+			},
 		},
 	}
 }
@@ -85,7 +101,7 @@ func (r *pbsResourceResource) Configure(_ context.Context, req resource.Configur
 }
 
 func (r *pbsResourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var resourceModel pbsResourceModel
+	var resourceModel pbsResourceResourceModel
 	var pbsResource pbsclient.PbsResource
 	diags := req.Plan.Get(ctx, &resourceModel)
 	resp.Diagnostics.Append(diags...)
@@ -93,23 +109,37 @@ func (r *pbsResourceResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	// Check the scheduler configuration first so that a resource isn't created which can't be made unschedulable
+	schedConfigPath := resourceModel.SchedConfigPath.ValueString()
+	if err := r.checkSchedulable(schedConfigPath, resourceModel.Schedulable); err != nil {
+		resp.Diagnostics.AddError("Error creating resource", "Could not update the scheduler configuration, unexpected error: "+err.Error())
+		return
+	}
+
 	pbsResource, err := r.client.CreateResource(resourceModel.ToPbsResource())
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating resource", "Could not create resource, unexpected error: "+err.Error())
 		return
 	}
 
-	resourceModel = createPbsResoureModel(pbsResource)
+	// The resource has to exist before the scheduler is told to check it
+	config, err := r.setSchedulable(schedConfigPath, pbsResource.Name, resourceModel.Schedulable, &resp.Diagnostics)
+
+	resourceModel = createPbsResourceResourceModel(pbsResource, config, resourceModel.Schedulable)
+	resourceModel.SchedConfigPath = types.StringValue(schedConfigPath)
 
+	// The resource is saved even if the scheduler configuration couldn't be updated, the error then taints it
+	// rather than leaving it behind in PBS without being tracked
 	diags = resp.State.Set(ctx, resourceModel)
 	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating resource", "Could not update the scheduler configuration, unexpected error: "+err.Error())
 		return
 	}
 }
 
 func (r *pbsResourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data pbsResourceModel
+	var data pbsResourceResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
@@ -135,15 +165,30 @@ func (r *pbsResourceResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	rModel := createPbsResoureModel(pbsResource)
+	schedConfigPath := pbsResourceSchedConfigPath(data.SchedConfigPath)
+	config := pbsclient.SchedConfig{}
+	if !data.Schedulable.IsNull() {
+		config, err = r.client.GetSchedConfig(schedConfigPath)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the scheduler configuration, got error: %s", err))
+			return
+		}
+	}
+
+	rModel := createPbsResourceResourceModel(pbsResource, config, data.Schedulable)
+	rModel.SchedConfigPath = types.StringValue(schedConfigPath)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &rModel)...)
 }
 
 func (r *pbsResourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data pbsResourceModel
+	var data, state pbsResourceResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	updatedResource, err := r.client.UpdateResource(data.ToPbsResource())
 	if err != nil {
@@ -157,15 +202,37 @@ func (r *pbsResourceResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	// A resource that is no longer managed as schedulable, or is now managed in a different file, is taken back off
+	// the resources line it was added to
+	priorPath := pbsResourceSchedConfigPath(state.SchedConfigPath)
+	schedConfigPath := data.SchedConfigPath.ValueString()
+	var config pbsclient.SchedConfig
+	if state.Schedulable.ValueBool() && (data.Schedulable.IsNull() || priorPath != schedConfigPath) {
+		err = r.removeSchedulable(priorPath, updatedResource.Name, &resp.Diagnostics)
+	}
+	if err == nil {
+		config, err = r.setSchedulable(schedConfigPath, updatedResource.Name, data.Schedulable, &resp.Diagnostics)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the scheduler configuration. "+
+				"HTTP Error: "+err.Error(),
+		)
+
+		return
+	}
+
 	// Create the model from the updated resource to ensure all fields including ID are properly set
-	updatedModel := createPbsResoureModel(updatedResource)
+	updatedModel := createPbsResourceResourceModel(updatedResource, config, data.Schedulable)
+	updatedModel.SchedConfigPath = data.SchedConfigPath
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedModel)...)
 }
 
 func (r *pbsResourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data pbsResourceModel
+	var data pbsResourceResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
@@ -173,6 +240,14 @@ func (r *pbsResourceResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	// The scheduler stops checking the resource before it is deleted
+	if data.Schedulable.ValueBool() {
+		if err := r.removeSchedulable(pbsResourceSchedConfigPath(data.SchedConfigPath), data.Name.ValueString(), &resp.Diagnostics); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update the scheduler configuration, got error: %s", err))
+			return
+		}
+	}
+
 	err := r.client.DeleteResource(data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete resource, got error: %s", err))
@@ -184,3 +259,62 @@ func (r *pbsResourceResource) ImportState(ctx context.Context, req resource.Impo
 	// Use the standard passthrough for ID, which will set both id and trigger a Read
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// pbsResourceSchedConfigPath returns the sched_config path from the state, which is null for resources imported
+// or created before the path could be configured.
+func pbsResourceSchedConfigPath(value types.String) string {
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return pbsclient.DefaultSchedConfigPath
+	}
+	return value.ValueString()
+}
+
+// checkSchedulable returns an error if schedulable is false but sched_config has no resources line, as the
+// scheduler then checks every resource so there is no way to leave one out.
+func (r *pbsResourceResource) checkSchedulable(path string, schedulable types.Bool) error {
+	if schedulable.IsNull() || schedulable.IsUnknown() || schedulable.ValueBool() {
+		return nil
+	}
+
+	config, err := r.client.GetSchedConfig(path)
+	if err != nil {
+		return err
+	}
+
+	return checkSchedulableConfig(path, config, false)
+}
+
+// checkSchedulableConfig returns an error if the resource can't be left out of the resources line in config.
+func checkSchedulableConfig(path string, config pbsclient.SchedConfig, schedulable bool) error {
+	if !schedulable && config.Resources() == nil {
+		return fmt.Errorf("%s has no resources line so the scheduler checks every resource, add one to set schedulable to false", path)
+	}
+
+	return nil
+}
+
+// setSchedulable adds the resource to or removes it from the resources line in sched_config, and reloads the
+// scheduler if that changed it. Nothing is done if schedulable is null. The resulting configuration is returned.
+func (r *pbsResourceResource) setSchedulable(path string, name string, schedulable types.Bool, diags *diag.Diagnostics) (pbsclient.SchedConfig, error) {
+	if schedulable.IsNull() || schedulable.IsUnknown() {
+		return pbsclient.SchedConfig{}, nil
+	}
+
+	config, err := r.client.EditSchedConfig(path, func(config *pbsclient.SchedConfig) {
+		config.SetResourceSchedulable(name, schedulable.ValueBool())
+	})
+	if err = warnIfSchedulerNotRunning(diags, err); err != nil {
+		return config, err
+	}
+
+	return config, checkSchedulableConfig(path, config, schedulable.ValueBool())
+}
+
+// removeSchedulable takes the resource off the resources line in sched_config if it is on it.
+func (r *pbsResourceResource) removeSchedulable(path string, name string, diags *diag.Diagnostics) error {
+	_, err := r.client.EditSchedConfig(path, func(config *pbsclient.SchedConfig) {
+		config.SetResourceSchedulable(name, false)
+	})
+
+	return warnIfSchedulerNotRunning(diags, err)
+}
@@ -2,8 +2,10 @@ package provider
 
 import (
 	"fmt"
+	"terraform-provider-pbs/internal/pbsclient"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -233,3 +235,58 @@ resource "pbs_resource" "test" {
 }
 `, name)
 }
+
+func TestCreatePbsResourceResourceModelSchedulable(t *testing.T) {
+	config := pbsclient.ParseSchedConfig("resources: \"ncpus, mem, ngpus\"\n")
+	tests := []struct {
+		name  string
+		prior types.Bool
+		want  types.Bool
+	}{
+		{"ngpus", types.BoolValue(false), types.BoolValue(true)},
+		{"nmics", types.BoolValue(true), types.BoolValue(false)},
+		{"ngpus", types.BoolNull(), types.BoolNull()},
+	}
+
+	for _, tt := range tests {
+		model := createPbsResourceResourceModel(pbsclient.PbsResource{Name: tt.name, Type: "long"}, config, tt.prior)
+		if !model.Schedulable.Equal(tt.want) {
+			t.Errorf("%s: got %s, wanted %s", tt.name, model.Schedulable, tt.want)
+		}
+	}
+}
+
+func TestAccPbsResourceResource_schedulable(t *testing.T) {
+	resourceName := testAccResourceName("test_sched_resource")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckPbsResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPbsResourceResourceConfigSchedulable(resourceName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_resource.test", "schedulable", "true"),
+				),
+			},
+			{
+				Config: testAccPbsResourceResourceConfigSchedulable(resourceName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_resource.test", "schedulable", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPbsResourceResourceConfigSchedulable(name string, schedulable bool) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_resource" "test" {
+  name        = %[1]q
+  type        = "long"
+  flag        = "nh"
+  schedulable = %[2]t
+}
+`, name, schedulable)
+}
@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-pbs/internal/pbsclient"
+)
+
+func NewPbsResourcesDataSource() datasource.DataSource {
+	return &pbsResourcesDataSource{}
+}
+
+type pbsResourcesDataSource struct {
+	client *pbsclient.PbsClient
+}
+
+type pbsResourcesDataSourceModel struct {
+	ID        types.String       `tfsdk:"id"`
+	Type      types.String       `tfsdk:"type"`
+	Names     []types.String     `tfsdk:"names"`
+	Resources []pbsResourceModel `tfsdk:"resources"`
+}
+
+func (d *pbsResourcesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resources"
+}
+
+func (d *pbsResourcesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescPbsResourcesID,
+			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: DescPbsResourcesType,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile("^(boolean|string|long|size|float|string_array)$"),
+						"resource type must be one of boolean|string|long|size|float|string_array",
+					),
+				},
+			},
+			"names": schema.ListAttribute{
+				Computed:            true,
+				MarkdownDescription: DescPbsResourcesNames,
+				ElementType:         types.StringType,
+			},
+			"resources": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: DescPbsResourcesResources,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescPbsResourceID,
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescPbsResourceName,
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescPbsResourceType,
+						},
+						"flag": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescPbsResourceFlag,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *pbsResourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	data := pbsResourcesDataSourceModel{}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all, err := d.client.GetResources()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to connect to PBS server and get resource information", err.Error())
+		return
+	}
+
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+
+	data.ID = types.StringValue("resources")
+	data.Names = []types.String{}
+	data.Resources = []pbsResourceModel{}
+	for _, r := range all {
+		if !data.Type.IsNull() && r.Type != data.Type.ValueString() {
+			continue
+		}
+
+		data.Names = append(data.Names, types.StringValue(r.Name))
+		data.Resources = append(data.Resources, createPbsResoureModel(r))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *pbsResourcesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pbsclient.PbsClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *PbsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -8,6 +8,7 @@ import (
 	"terraform-provider-pbs/internal/pbsclient"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -17,7 +18,8 @@ import (
 )
 
 var (
-	_ provider.Provider = &pbsProvider{}
+	_ provider.Provider              = &pbsProvider{}
+	_ provider.ProviderWithFunctions = &pbsProvider{}
 )
 
 type pbsProviderModel struct {
@@ -209,36 +211,17 @@ func (p *pbsProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 
-	// Build SSH authentication methods
-	var authMethods []ssh.AuthMethod
-
-	// Add password authentication if provided
-	if hasPassword {
-		authMethods = append(authMethods, ssh.Password(password))
-	}
-
-	// Add SSH key authentication if provided
-	if hasSshKey {
-		// Parse the private key
-		signer, err := ssh.ParsePrivateKey([]byte(sshPrivateKey))
-		if err != nil {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("ssh_private_key"),
-				"Invalid SSH private key",
-				fmt.Sprintf("Cannot parse SSH private key: %v", err),
-			)
-			return
-		}
-
-		authMethods = append(authMethods, ssh.PublicKeys(signer))
+	sshConfig, err := newSshClientConfig(username, password, sshPrivateKey)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ssh_private_key"),
+			"Invalid SSH private key",
+			fmt.Sprintf("Cannot parse SSH private key: %v", err),
+		)
+		return
 	}
 
 	// Create a new SSH client using the configuration values
-	sshConfig := &ssh.ClientConfig{
-		User:            username,
-		Auth:            authMethods,
-		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
-	}
 	pbsClient := &pbsclient.PbsClient{
 		SshClientConfig: sshConfig,
 		Address:         net.JoinHostPort(server, sshPort),
@@ -253,9 +236,15 @@ func (p *pbsProvider) Configure(ctx context.Context, req provider.ConfigureReque
 func (p *pbsProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewQueueDataSource,
+		NewQueuesDataSource,
 		NewPbsResourceDataSource,
+		NewPbsResourcesDataSource,
 		NewPbsHookDataSource,
+		NewPbsHooksDataSource,
 		NewPbsNodeDataSource,
+		NewPbsNodesDataSource,
+		NewPbsJobsDataSource,
+		NewFairshareUsageDataSource,
 		NewServerDataSource,
 	}
 }
@@ -267,5 +256,59 @@ func (p *pbsProvider) Resources(_ context.Context) []func() resource.Resource {
 		NewPbsHookResource,
 		NewPbsNodeResource,
 		NewServerResource,
+		NewPbsBuiltinHookResource,
+		NewServerManagerResource,
+		NewServerOperatorResource,
+		NewQueueAclEntryResource,
+		NewServerAclEntryResource,
+		NewServerAttributeResource,
+		NewQueueAttributeResource,
+		NewNodeAttributeResource,
+		NewNodePoolResource,
+		NewReservationResource,
+		NewMaintenanceReservationResource,
+		NewSchedConfigResource,
+		NewFairshareTreeResource,
+		NewHolidaysResource,
+		NewDedicatedTimeResource,
+		NewMomConfigResource,
+		NewVnodeDefinitionResource,
+		NewPbsConfResource,
+		NewDaemonActionResource,
+	}
+}
+
+func (p *pbsProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewExpandHostlistFunction,
+		NewCompactHostlistFunction,
+	}
+}
+
+// newSshClientConfig builds the SSH configuration used to run commands, authenticating with the password and/or
+// private key that are set.
+func newSshClientConfig(username string, password string, sshPrivateKey string) (*ssh.ClientConfig, error) {
+	var authMethods []ssh.AuthMethod
+
+	// Add password authentication if provided
+	if password != "" {
+		authMethods = append(authMethods, ssh.Password(password))
+	}
+
+	// Add SSH key authentication if provided
+	if sshPrivateKey != "" {
+		// Parse the private key
+		signer, err := ssh.ParsePrivateKey([]byte(sshPrivateKey))
+		if err != nil {
+			return nil, err
+		}
+
+		authMethods = append(authMethods, ssh.PublicKeys(signer))
 	}
+
+	return &ssh.ClientConfig{
+		User:            username,
+		Auth:            authMethods,
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	}, nil
 }
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"terraform-provider-pbs/internal/pbsclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &queueAclEntryResource{}
+	_ resource.ResourceWithConfigure   = &queueAclEntryResource{}
+	_ resource.ResourceWithImportState = &queueAclEntryResource{}
+)
+
+// aclEntryRegex matches a single ACL entry with an optional +/- prefix. Commas and quotes are rejected
+// as they would either split the entry or break the qmgr command.
+var aclEntryRegex = regexp.MustCompile(`^[+-]?[^+\-,\s'"][^,\s'"]*$`)
+
+func NewQueueAclEntryResource() resource.Resource {
+	return &queueAclEntryResource{}
+}
+
+// queueAclEntryResource owns exactly one entry in one of a queue's ACLs so that several teams can
+// grant access to a queue without owning the whole ACL.
+type queueAclEntryResource struct {
+	client *pbsclient.PbsClient
+}
+
+type queueAclEntryModel struct {
+	ID    types.String `tfsdk:"id"`
+	Queue types.String `tfsdk:"queue"`
+	Acl   types.String `tfsdk:"acl"`
+	Entry types.String `tfsdk:"entry"`
+}
+
+func (r *queueAclEntryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_queue_acl_entry"
+}
+
+func (r *queueAclEntryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescQueueAclEntryID,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"queue": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: DescQueueAclEntryQueue,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"acl": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: DescQueueAclEntryAcl,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("acl_users", "acl_groups", "acl_hosts"),
+				},
+			},
+			"entry": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: DescAclEntryEntry,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(aclEntryRegex, "must be a single ACL entry with an optional + or - prefix"),
+				},
+			},
+		},
+	}
+}
+
+func (r *queueAclEntryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pbsclient.PbsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pbsclient.PbsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *queueAclEntryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model queueAclEntryModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.AddQueueListEntry(model.Queue.ValueString(), model.Acl.ValueString(), model.Entry.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating resource", fmt.Sprintf("Could not add %s to %s on queue %s, unexpected error: %s", model.Entry.ValueString(), model.Acl.ValueString(), model.Queue.ValueString(), err))
+		return
+	}
+
+	model.ID = types.StringValue(strings.Join([]string{model.Queue.ValueString(), model.Acl.ValueString(), model.Entry.ValueString()}, "/"))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *queueAclEntryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state queueAclEntryModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// For import, split the ID into its parts
+	if state.Queue.IsNull() && !state.ID.IsNull() {
+		parts := strings.SplitN(state.ID.ValueString(), "/", 3)
+		if len(parts) != 3 {
+			resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected an ID of the form <queue>/<acl>/<entry>, got: %s", state.ID.ValueString()))
+			return
+		}
+		state.Queue = types.StringValue(parts[0])
+		state.Acl = types.StringValue(parts[1])
+		state.Entry = types.StringValue(parts[2])
+	}
+
+	entries, found, err := r.client.GetQueueListEntries(state.Queue.ValueString(), state.Acl.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read queue %s, got error: %s", state.Acl.ValueString(), err))
+		return
+	}
+
+	// If the queue or the entry has been removed outside of terraform, remove it from the state
+	if _, ok := pbsclient.FindListEntry(entries, state.Entry.ValueString()); !found || !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(strings.Join([]string{state.Queue.ValueString(), state.Acl.ValueString(), state.Entry.ValueString()}, "/"))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *queueAclEntryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute requires replacement so there is nothing to do on the server
+	var model queueAclEntryModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.ID = types.StringValue(strings.Join([]string{model.Queue.ValueString(), model.Acl.ValueString(), model.Entry.ValueString()}, "/"))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *queueAclEntryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data queueAclEntryModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveQueueListEntry(data.Queue.ValueString(), data.Acl.ValueString(), data.Entry.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove %s from %s on queue %s, got error: %s", data.Entry.ValueString(), data.Acl.ValueString(), data.Queue.ValueString(), err))
+		return
+	}
+}
+
+func (r *queueAclEntryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the standard passthrough for ID, which will set both id and trigger a Read
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccQueueAclEntryResource_basic(t *testing.T) {
+	queueName := testAccResourceName("acl_q")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			// Two independent entries on a queue owned by a separate resource
+			{
+				Config: testAccQueueAclEntryResourceConfig(queueName, "+alice@*", "-bob@*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_queue_acl_entry.first", "id", queueName+"/acl_users/+alice@*"),
+					resource.TestCheckResourceAttr("pbs_queue_acl_entry.second", "entry", "-bob@*"),
+					resource.TestCheckResourceAttrWith("data.pbs_queue.test", "acl_users", testAccCheckListContains("+alice@*")),
+					resource.TestCheckResourceAttrWith("data.pbs_queue.test", "acl_users", testAccCheckListContains("-bob@*")),
+				),
+			},
+			// The queue picks up the entries on refresh without planning to remove them
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("pbs_queue.test", "acl_users", testAccCheckListContains("+alice@*")),
+					resource.TestCheckResourceAttrWith("pbs_queue.test", "acl_users", testAccCheckListContains("-bob@*")),
+				),
+			},
+			{
+				ResourceName:      "pbs_queue_acl_entry.first",
+				ImportState:       true,
+				ImportStateId:     queueName + "/acl_users/+alice@*",
+				ImportStateVerify: true,
+			},
+			// Replacing one entry leaves the other alone
+			{
+				Config: testAccQueueAclEntryResourceConfig(queueName, "+carol@*", "-bob@*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("data.pbs_queue.test", "acl_users", testAccCheckListContains("+carol@*")),
+					resource.TestCheckResourceAttrWith("data.pbs_queue.test", "acl_users", testAccCheckListContains("-bob@*")),
+				),
+			},
+		},
+	})
+}
+
+func testAccQueueAclEntryResourceConfig(queueName, first, second string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_queue" "test" {
+  name       = %[1]q
+  queue_type = "Execution"
+  enabled    = true
+  started    = true
+}
+
+resource "pbs_queue_acl_entry" "first" {
+  queue = pbs_queue.test.name
+  acl   = "acl_users"
+  entry = %[2]q
+}
+
+resource "pbs_queue_acl_entry" "second" {
+  queue = pbs_queue.test.name
+  acl   = "acl_users"
+  entry = %[3]q
+}
+
+data "pbs_queue" "test" {
+  name       = pbs_queue.test.name
+  depends_on = [pbs_queue_acl_entry.first, pbs_queue_acl_entry.second]
+}
+`, queueName, first, second)
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccQueueAttributeResource_basic(t *testing.T) {
+	queueName := testAccResourceName("attr_q")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			// The queue ignores the attributes owned by pbs_queue_attribute so the plan stays empty
+			{
+				Config: testAccQueueAttributeResourceConfig(queueName, 50, "4"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_queue_attribute.priority", "id", queueName+"/priority"),
+					resource.TestCheckResourceAttr("pbs_queue_attribute.ngpus", "id", queueName+"/resources_max.ngpus"),
+					resource.TestCheckResourceAttr("data.pbs_queue.test", "priority", "50"),
+					resource.TestCheckResourceAttr("data.pbs_queue.test", "resources_max.ngpus", "4"),
+					resource.TestCheckNoResourceAttr("pbs_queue.test", "priority"),
+				),
+			},
+			{
+				ResourceName:      "pbs_queue_attribute.ngpus",
+				ImportState:       true,
+				ImportStateId:     queueName + "/resources_max.ngpus",
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccQueueAttributeResourceConfig(queueName, 75, "8"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pbs_queue.test", "priority", "75"),
+					resource.TestCheckResourceAttr("data.pbs_queue.test", "resources_max.ngpus", "8"),
+					resource.TestCheckResourceAttr("data.pbs_queue.test", "resources_max.ncpus", "16"),
+				),
+			},
+		},
+	})
+}
+
+func testAccQueueAttributeResourceConfig(queueName string, priority int, ngpus string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_queue" "test" {
+  name       = %[1]q
+  queue_type = "Execution"
+  enabled    = true
+  started    = true
+  resources_max = {
+    ncpus = "16"
+  }
+  ignore_attributes = ["priority", "resources_max.ngpus"]
+}
+
+resource "pbs_queue_attribute" "priority" {
+  queue = pbs_queue.test.name
+  name  = "priority"
+  value = "%[2]d"
+}
+
+resource "pbs_queue_attribute" "ngpus" {
+  queue    = pbs_queue.test.name
+  name     = "resources_max"
+  resource = "ngpus"
+  value    = %[3]q
+}
+
+data "pbs_queue" "test" {
+  name       = pbs_queue.test.name
+  depends_on = [pbs_queue_attribute.priority, pbs_queue_attribute.ngpus]
+}
+`, queueName, priority, ngpus)
+}
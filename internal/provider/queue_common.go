@@ -62,6 +62,52 @@ type queueModel struct {
 	Started                types.Bool              `tfsdk:"started"`
 }
 
+// queueResourceModel extends the queue model shared with the data source with resource only settings.
+type queueResourceModel struct {
+	queueModel
+	IgnoreAttributes   []types.String `tfsdk:"ignore_attributes"`
+	DestroyPolicy      types.String   `tfsdk:"destroy_policy"`
+	DestroyTimeout     types.Int32    `tfsdk:"destroy_timeout"`
+	DestroyDestination types.String   `tfsdk:"destroy_destination"`
+}
+
+// queueDataSourceModel extends the queue model shared with the resource with the runtime statistics reported
+// by the server, which can only be read.
+type queueDataSourceModel struct {
+	queueModel
+	TotalJobs         types.Int32             `tfsdk:"total_jobs"`
+	StateCount        map[string]types.Int32  `tfsdk:"state_count"`
+	ResourcesAssigned map[string]types.String `tfsdk:"resources_assigned"`
+	HasNodes          types.Bool              `tfsdk:"hasnodes"`
+}
+
+func createQueueDataSourceModel(q pbsclient.PbsQueue) queueDataSourceModel {
+	model := queueDataSourceModel{
+		queueModel: createQueueModel(q),
+		TotalJobs:  types.Int32PointerValue(q.TotalJobs),
+		StateCount: convertStateCount(q.StateCount),
+		HasNodes:   types.BoolPointerValue(q.HasNodes),
+	}
+
+	if len(q.ResourcesAssigned) > 0 {
+		model.ResourcesAssigned = convertStringMapToTypesStringMap(q.ResourcesAssigned)
+	}
+
+	return model
+}
+
+// createQueueResourceModel builds the resource model from the queue returned by PBS, carrying the resource
+// only settings over from the prior plan or state.
+func createQueueResourceModel(q pbsclient.PbsQueue, prior queueResourceModel) queueResourceModel {
+	return queueResourceModel{
+		queueModel:         createQueueModel(q),
+		IgnoreAttributes:   prior.IgnoreAttributes,
+		DestroyPolicy:      prior.DestroyPolicy,
+		DestroyTimeout:     prior.DestroyTimeout,
+		DestroyDestination: prior.DestroyDestination,
+	}
+}
+
 func (m queueModel) ToPbsQueue(ctx context.Context) (pbsclient.PbsQueue, diag.Diagnostics) {
 	queue := pbsclient.PbsQueue{
 		Name:      m.Name.ValueString(),
@@ -72,11 +118,11 @@ func (m queueModel) ToPbsQueue(ctx context.Context) (pbsclient.PbsQueue, diag.Di
 
 	// Only set pointer fields if the value is not null
 	SetBoolPointerIfNotNull(m.AclGroupEnable, &queue.AclGroupEnable)
-	SetStringPointerIfNotNull(m.AclGroups, &queue.AclGroups)
+	SetListPointerIfNotEmpty(m.AclGroups, &queue.AclGroups)
 	SetBoolPointerIfNotNull(m.AclHostEnable, &queue.AclHostEnable)
-	SetStringPointerIfNotNull(m.AclHosts, &queue.AclHosts)
+	SetListPointerIfNotEmpty(m.AclHosts, &queue.AclHosts)
 	SetBoolPointerIfNotNull(m.AclUserEnable, &queue.AclUserEnable)
-	SetStringPointerIfNotNull(m.AclUsers, &queue.AclUsers)
+	SetListPointerIfNotEmpty(m.AclUsers, &queue.AclUsers)
 	SetStringPointerIfNotNull(m.AltRouter, &queue.AltRouter)
 	SetInt32PointerIfNotNull(m.BackfillDepth, &queue.BackfillDepth)
 	SetInt32PointerIfNotNull(m.CheckpointMin, &queue.CheckpointMin)
@@ -242,12 +242,30 @@ func (d *queueDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed:            true,
 				MarkdownDescription: DescQueueStarted,
 			},
+			"total_jobs": schema.Int32Attribute{
+				Computed:            true,
+				MarkdownDescription: DescQueueTotalJobs,
+			},
+			"state_count": schema.MapAttribute{
+				Computed:            true,
+				MarkdownDescription: DescQueueStateCount,
+				ElementType:         types.Int32Type,
+			},
+			"resources_assigned": schema.MapAttribute{
+				Computed:            true,
+				MarkdownDescription: DescQueueResourcesAssigned,
+				ElementType:         types.StringType,
+			},
+			"hasnodes": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: DescQueueHasNodes,
+			},
 		},
 	}
 }
 
 func (d *queueDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	sourceData := queueModel{}
+	sourceData := queueDataSourceModel{}
 	resp.Diagnostics.Append(req.Config.Get(ctx, &sourceData)...)
 
 	resultData, err := d.client.GetQueue(sourceData.Name.ValueString())
@@ -256,7 +274,7 @@ func (d *queueDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	queueModel := createQueueModel(resultData)
+	queueModel := createQueueDataSourceModel(resultData)
 
 	diag := resp.State.Set(ctx, &queueModel)
 	resp.Diagnostics.Append(diag...)
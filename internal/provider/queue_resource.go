@@ -6,8 +6,10 @@ import (
 	"strings"
 	"terraform-provider-pbs/internal/pbsclient"
 	validators "terraform-provider-pbs/internal/provider/validators"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,12 +18,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource                = &queueResource{}
-	_ resource.ResourceWithConfigure   = &queueResource{}
-	_ resource.ResourceWithImportState = &queueResource{}
+	_ resource.Resource                   = &queueResource{}
+	_ resource.ResourceWithConfigure      = &queueResource{}
+	_ resource.ResourceWithImportState    = &queueResource{}
+	_ resource.ResourceWithValidateConfig = &queueResource{}
+)
+
+const (
+	queueDestroyPolicyDisable = "disable"
+	queueDestroyPolicyMove    = "move"
+	queueDestroyPolicyFail    = "fail"
+
+	defaultQueueDestroyTimeout = time.Hour
+	queueDrainPollInterval     = 15 * time.Second
 )
 
 func NewQueueResource() resource.Resource {
@@ -43,6 +56,32 @@ func (r *queueResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:            true,
 				MarkdownDescription: DescQueueID,
 			},
+			"ignore_attributes": schema.SetAttribute{
+				MarkdownDescription: DescIgnoreAttributes,
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(newIgnoreAttributesValidator(queueModel{})),
+				},
+			},
+			"destroy_policy": schema.StringAttribute{
+				MarkdownDescription: DescQueueDestroyPolicy,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(queueDestroyPolicyDisable, queueDestroyPolicyMove, queueDestroyPolicyFail),
+				},
+			},
+			"destroy_timeout": schema.Int32Attribute{
+				MarkdownDescription: DescQueueDestroyTimeout,
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"destroy_destination": schema.StringAttribute{
+				MarkdownDescription: DescQueueDestroyDestination,
+				Optional:            true,
+			},
 			"acl_group_enable": schema.BoolAttribute{
 				MarkdownDescription: DescQueueAclGroupEnable,
 				Optional:            true,
@@ -50,9 +89,13 @@ func (r *queueResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"acl_groups": schema.StringAttribute{
 				MarkdownDescription: DescQueueAclGroups,
 				Optional:            true,
+				Computed:            true,
 				Validators: []validator.String{
 					validators.PbsString(),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"acl_groups_normalized": schema.StringAttribute{
 				MarkdownDescription: DescQueueAclGroupsNormalized + " This field is computed and reflects the actual value used by PBS.",
@@ -65,9 +108,13 @@ func (r *queueResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"acl_hosts": schema.StringAttribute{
 				MarkdownDescription: DescQueueAclHosts,
 				Optional:            true,
+				Computed:            true,
 				Validators: []validator.String{
 					validators.PbsString(),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"acl_hosts_normalized": schema.StringAttribute{
 				MarkdownDescription: DescQueueAclHostsNormalized + " This field is computed and reflects the actual value used by PBS.",
@@ -80,9 +127,13 @@ func (r *queueResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"acl_users": schema.StringAttribute{
 				MarkdownDescription: DescQueueAclUsers,
 				Optional:            true,
+				Computed:            true,
 				Validators: []validator.String{
 					validators.PbsString(),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"acl_users_normalized": schema.StringAttribute{
 				MarkdownDescription: DescQueueAclUsersNormalized + " This field is computed and reflects the actual value used by PBS.",
@@ -334,7 +385,7 @@ func (r *queueResource) Configure(_ context.Context, req resource.ConfigureReque
 }
 
 func (r *queueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var planModel queueModel
+	var planModel queueResourceModel
 	diags := req.Plan.Get(ctx, &planModel)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -350,10 +401,13 @@ func (r *queueResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Create the model from the queue returned by PBS.
-	resultModel := createQueueModel(queue)
+	resultModel := createQueueResourceModel(queue, planModel)
 
 	// Preserve the user's original format for ACL fields from the plan.
-	preserveUserAclFormat(&planModel, &resultModel)
+	preserveUserAclFormat(&planModel.queueModel, &resultModel.queueModel)
+
+	// Attributes managed elsewhere are reported as configured
+	preserveIgnoredAttributes(planModel.IgnoreAttributes, &planModel.queueModel, &resultModel.queueModel)
 
 	diags = resp.State.Set(ctx, resultModel)
 	resp.Diagnostics.Append(diags...)
@@ -363,7 +417,7 @@ func (r *queueResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *queueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var state queueModel
+	var state queueResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
@@ -395,7 +449,7 @@ func (r *queueResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Update state with current values, preserving plan-only values
-	updatedState := createQueueModel(q)
+	updatedState := createQueueResourceModel(q, state)
 	// Preserve the name from the original state to avoid unnecessary changes,
 	// but only if it's not empty (during import, state.Name will be empty)
 	if !state.Name.IsNull() && state.Name.ValueString() != "" {
@@ -403,17 +457,42 @@ func (r *queueResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Preserve the user's ACL format if it's semantically equivalent to what PBS returned
-	preserveUserAclFormatFromState(&state, &updatedState)
+	preserveUserAclFormatFromState(&state.queueModel, &updatedState.queueModel)
+
+	// Attributes managed elsewhere never show up as drift
+	preserveIgnoredAttributes(state.IgnoreAttributes, &state.queueModel, &updatedState.queueModel)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedState)...)
 }
 
 func (r *queueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var planModel queueModel
+	var planModel, stateModel queueResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	queue, diags := planModel.ToPbsQueue(ctx)
+	current, err := r.client.GetQueue(planModel.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read queue, got error: %s", err))
+		return
+	}
+	currentModel := createQueueModel(current)
+
+	// Attributes managed elsewhere keep whatever value they currently have on the server
+	desired := planModel.queueModel
+	preserveIgnoredAttributes(planModel.IgnoreAttributes, &currentModel, &desired)
+
+	// The ACLs are only written when the configuration changes them, so that entries pbs_queue_acl_entry adds in the
+	// same apply aren't overwritten
+	keepUnchangedList(planModel.AclGroups, stateModel.AclGroups, currentModel.AclGroups, &desired.AclGroups)
+	keepUnchangedList(planModel.AclHosts, stateModel.AclHosts, currentModel.AclHosts, &desired.AclHosts)
+	keepUnchangedList(planModel.AclUsers, stateModel.AclUsers, currentModel.AclUsers, &desired.AclUsers)
+
+	queue, diags := desired.ToPbsQueue(ctx)
 	resp.Diagnostics.Append(diags...)
 	updatedQueue, err := r.client.UpdateQueue(queue)
 	if err != nil {
@@ -428,17 +507,20 @@ func (r *queueResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Create the model from the updated queue to ensure all fields including ID are properly set
-	updatedModel := createQueueModel(updatedQueue)
+	updatedModel := createQueueResourceModel(updatedQueue, planModel)
 
 	// Preserve the user's original format for ACL fields from the plan
-	preserveUserAclFormat(&planModel, &updatedModel)
+	preserveUserAclFormat(&planModel.queueModel, &updatedModel.queueModel)
+
+	// Attributes managed elsewhere are reported as configured
+	preserveIgnoredAttributes(planModel.IgnoreAttributes, &planModel.queueModel, &updatedModel.queueModel)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedModel)...)
 }
 
 func (r *queueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var queue queueModel
+	var queue queueResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &queue)...)
 
@@ -446,6 +528,11 @@ func (r *queueResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	if err := r.emptyQueue(ctx, queue); err != nil {
+		resp.Diagnostics.AddError("Unable to Empty Queue", fmt.Sprintf("Queue %s was not deleted: %s", queue.Name.ValueString(), err))
+		return
+	}
+
 	err := r.client.DeleteQueue(queue.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete queue, got error: %s", err))
@@ -453,6 +540,124 @@ func (r *queueResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+func (r *queueResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// Only read the attributes being checked, the rest of the config may still contain unknown values
+	var destroyPolicy, destroyDestination types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("destroy_policy"), &destroyPolicy)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("destroy_destination"), &destroyDestination)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if destroyPolicy.IsUnknown() || destroyDestination.IsUnknown() {
+		return
+	}
+
+	isMove := destroyPolicy.ValueString() == queueDestroyPolicyMove
+	if isMove && destroyDestination.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("destroy_destination"), "Missing Destroy Destination", "destroy_destination must be set when destroy_policy is \"move\".")
+	}
+	if !isMove && !destroyDestination.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("destroy_destination"), "Unused Destroy Destination", "destroy_destination can only be set when destroy_policy is \"move\".")
+	}
+}
+
+// emptyQueue applies the destroy policy so that the queue has no jobs left in it when it is deleted. With no
+// policy set the queue is deleted straight away and qmgr refuses if any jobs remain.
+func (r *queueResource) emptyQueue(ctx context.Context, data queueResourceModel) error {
+	name := data.Name.ValueString()
+	policy := data.DestroyPolicy.ValueString()
+	if policy == "" {
+		return nil
+	}
+
+	timeout := defaultQueueDestroyTimeout
+	if !data.DestroyTimeout.IsNull() {
+		timeout = time.Duration(data.DestroyTimeout.ValueInt32()) * time.Second
+	}
+
+	emptier := queueEmptier{
+		name:         name,
+		pollInterval: queueDrainPollInterval,
+		jobCounts: func() (int, map[string]int, bool, error) {
+			return r.client.GetQueueJobCounts(name)
+		},
+		disable: func() error {
+			disabled := "False"
+			return r.client.UpdateQueueAttribute(name, "enabled", nil, &disabled)
+		},
+		moveJobs: func(destination string) error {
+			return r.client.MoveQueueJobs(name, destination)
+		},
+	}
+
+	return emptier.empty(ctx, policy, data.DestroyDestination.ValueString(), timeout)
+}
+
+// queueEmptier decides when a queue that is being destroyed is empty, disabling it and moving its jobs as the
+// destroy policy asks. The queue is only accessed through jobCounts, disable and moveJobs.
+type queueEmptier struct {
+	name         string
+	pollInterval time.Duration
+	jobCounts    func() (int, map[string]int, bool, error)
+	disable      func() error
+	moveJobs     func(destination string) error
+}
+
+// empty applies policy to the queue and waits up to timeout for the remaining jobs to leave it. An error is
+// returned if jobs are still in the queue after that.
+func (e queueEmptier) empty(ctx context.Context, policy string, destination string, timeout time.Duration) error {
+	total, counts, found, err := e.jobCounts()
+	if err != nil || !found || total == 0 {
+		return err
+	}
+
+	if policy == queueDestroyPolicyFail {
+		return fmt.Errorf("the queue still has %d jobs (%s)", total, formatStateCount(counts))
+	}
+
+	// Stop new jobs arriving while the queue drains, running and queued jobs carry on as normal
+	if err := e.disable(); err != nil {
+		return err
+	}
+
+	if policy == queueDestroyPolicyMove {
+		tflog.Info(ctx, "Moving jobs out of queue", map[string]any{"queue": e.name, "destination": destination})
+		if err := e.moveJobs(destination); err != nil {
+			return fmt.Errorf("unable to move jobs to %s: %s", destination, err)
+		}
+	}
+
+	return e.wait(ctx, timeout)
+}
+
+// wait polls the queue until it has no jobs left or the timeout elapses.
+func (e queueEmptier) wait(ctx context.Context, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		total, counts, found, err := e.jobCounts()
+		if err != nil || !found || total == 0 {
+			return err
+		}
+		if !time.Now().Before(deadline) {
+			return fmt.Errorf("the queue still has %d jobs (%s) after %s and has been left disabled", total, formatStateCount(counts), timeout)
+		}
+
+		tflog.Info(ctx, "Waiting for jobs to leave queue", map[string]any{
+			"queue":     e.name,
+			"jobs":      total,
+			"remaining": time.Until(deadline).Round(time.Second).String(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(e.pollInterval):
+		}
+	}
+}
+
 func (r *queueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Use the standard passthrough for ID, which will set both id and trigger a Read
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
@@ -1,8 +1,13 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -327,6 +332,16 @@ func TestAccQueueResource_comprehensive_ACL(t *testing.T) {
 					resource.TestCheckResourceAttr("pbs_queue.test", "acl_group_enable", "true"),
 					resource.TestCheckResourceAttr("pbs_queue.test", "acl_groups", "staff,admin"),
 					resource.TestCheckResourceAttr("pbs_queue.test", "acl_groups_normalized", "admin,staff"),
+					// ACLs left out of the configuration keep their value
+					resource.TestCheckResourceAttr("pbs_queue.test", "acl_users", "testuser,pbsuser"),
+				),
+			},
+			// An empty ACL unsets it
+			{
+				Config: testAccQueueResourceConfigACLCleared(queueName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_queue.test", "acl_users", ""),
+					resource.TestCheckNoResourceAttr("pbs_queue.test", "acl_users_normalized"),
 				),
 			},
 		},
@@ -493,6 +508,23 @@ resource "pbs_queue" "test" {
 `, name)
 }
 
+func testAccQueueResourceConfigACLCleared(name string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_queue" "test" {
+  name             = "%[1]s"
+  queue_type       = "Execution"
+  enabled          = true
+  started          = true
+  acl_user_enable  = false
+  acl_users        = ""
+  acl_host_enable  = false
+  acl_group_enable = true
+  acl_groups       = "staff,admin"
+  priority         = 100
+}
+`, name)
+}
+
 func testAccQueueResourceConfigResourceLimits(name string) string {
 	return providerConfig() + fmt.Sprintf(`
 resource "pbs_queue" "test" {
@@ -725,6 +757,64 @@ func TestAccQueueResource_disabledQueue(t *testing.T) {
 	})
 }
 
+// TestAccQueueResource_destroyPolicy tests that an empty queue with a destroy policy is deleted cleanly.
+func TestAccQueueResource_destroyPolicy(t *testing.T) {
+	queueName := testAccResourceName("tq_destroy")
+	destinationName := testAccResourceName("tq_dest")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		CheckDestroy:             testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccQueueResourceConfigDestroyPolicy(queueName, destinationName, `destroy_policy = "move"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`destroy_destination must be set`),
+			},
+			{
+				Config: testAccQueueResourceConfigDestroyPolicy(queueName, destinationName, `
+  destroy_policy      = "move"
+  destroy_destination = pbs_queue.destination.name
+  destroy_timeout     = 60
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueueExists("pbs_queue.test"),
+					resource.TestCheckResourceAttr("pbs_queue.test", "destroy_policy", "move"),
+					resource.TestCheckResourceAttr("pbs_queue.test", "destroy_destination", destinationName),
+					resource.TestCheckResourceAttr("pbs_queue.test", "destroy_timeout", "60"),
+				),
+			},
+			{
+				Config: testAccQueueResourceConfigDestroyPolicy(queueName, destinationName, `destroy_policy = "fail"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_queue.test", "destroy_policy", "fail"),
+					resource.TestCheckNoResourceAttr("pbs_queue.test", "destroy_destination"),
+				),
+			},
+		},
+	})
+}
+
+func testAccQueueResourceConfigDestroyPolicy(name string, destination string, policy string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_queue" "destination" {
+  name       = %[2]q
+  queue_type = "Execution"
+  enabled    = true
+  started    = true
+}
+
+resource "pbs_queue" "test" {
+  name       = %[1]q
+  queue_type = "Execution"
+  enabled    = true
+  started    = true
+  %[3]s
+}
+`, name, destination, policy)
+}
+
 // TestAccQueueResource_limitsByUser tests user-specific limit configurations.
 func TestAccQueueResource_limitsByUser(t *testing.T) {
 	queueName := testAccResourceName("tq_userlimits")
@@ -869,3 +959,80 @@ resource "pbs_queue" "test" {
 }
 `, name)
 }
+
+func TestQueueEmptier(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      string
+		polls       int // polls before the queue is empty, -1 if it never is
+		moveErr     error
+		wantErr     string
+		wantDisable bool
+		wantMove    bool
+	}{
+		{name: "empty", policy: queueDestroyPolicyDisable, polls: 0},
+		{name: "fail", policy: queueDestroyPolicyFail, polls: -1, wantErr: "the queue still has 3 jobs (Queued: 2, Running: 1)"},
+		{name: "disable", policy: queueDestroyPolicyDisable, polls: 3, wantDisable: true},
+		{name: "move", policy: queueDestroyPolicyMove, polls: 2, wantDisable: true, wantMove: true},
+		{name: "move failed", policy: queueDestroyPolicyMove, polls: -1, moveErr: errors.New("qmove: unknown queue"), wantErr: "unable to move jobs to workq", wantDisable: true, wantMove: true},
+		{name: "timeout", policy: queueDestroyPolicyDisable, polls: -1, wantErr: "after 20ms and has been left disabled", wantDisable: true},
+	}
+
+	for _, tt := range tests {
+		polls := 0
+		disabled := false
+		moved := ""
+		emptier := queueEmptier{
+			name:         "work",
+			pollInterval: time.Millisecond,
+			jobCounts: func() (int, map[string]int, bool, error) {
+				if tt.polls >= 0 && polls >= tt.polls {
+					return 0, map[string]int{}, true, nil
+				}
+				polls++
+				return 3, map[string]int{"Queued": 2, "Running": 1}, true, nil
+			},
+			disable: func() error {
+				disabled = true
+				return nil
+			},
+			moveJobs: func(destination string) error {
+				moved = destination
+				return tt.moveErr
+			},
+		}
+
+		err := emptier.empty(context.Background(), tt.policy, "workq", 20*time.Millisecond)
+		if tt.wantErr == "" && err != nil {
+			t.Errorf("%s: got error %v, wanted none", tt.name, err)
+		}
+		if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+			t.Errorf("%s: got error %v, wanted %q", tt.name, err, tt.wantErr)
+		}
+		if disabled != tt.wantDisable {
+			t.Errorf("%s: got disabled %t, wanted %t", tt.name, disabled, tt.wantDisable)
+		}
+		if tt.wantMove != (moved == "workq") {
+			t.Errorf("%s: got jobs moved to %q, wanted move %t", tt.name, moved, tt.wantMove)
+		}
+	}
+}
+
+func TestQueueEmptierCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	emptier := queueEmptier{
+		name:         "work",
+		pollInterval: time.Hour,
+		jobCounts: func() (int, map[string]int, bool, error) {
+			return 1, map[string]int{"Running": 1}, true, nil
+		},
+		disable:  func() error { return nil },
+		moveJobs: func(string) error { return nil },
+	}
+
+	if err := emptier.empty(ctx, queueDestroyPolicyDisable, "", time.Hour); !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, wanted %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-pbs/internal/pbsclient"
+)
+
+func NewQueuesDataSource() datasource.DataSource {
+	return &queuesDataSource{}
+}
+
+type queuesDataSource struct {
+	client *pbsclient.PbsClient
+}
+
+type queuesDataSourceModel struct {
+	ID        types.String       `tfsdk:"id"`
+	QueueType types.String       `tfsdk:"queue_type"`
+	Enabled   types.Bool         `tfsdk:"enabled"`
+	Started   types.Bool         `tfsdk:"started"`
+	Names     []types.String     `tfsdk:"names"`
+	Queues    []queuesEntryModel `tfsdk:"queues"`
+}
+
+type queuesEntryModel struct {
+	Name       types.String           `tfsdk:"name"`
+	QueueType  types.String           `tfsdk:"queue_type"`
+	Enabled    types.Bool             `tfsdk:"enabled"`
+	Started    types.Bool             `tfsdk:"started"`
+	Priority   types.Int32            `tfsdk:"priority"`
+	Partition  types.String           `tfsdk:"partition"`
+	TotalJobs  types.Int32            `tfsdk:"total_jobs"`
+	StateCount map[string]types.Int32 `tfsdk:"state_count"`
+}
+
+func (d *queuesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_queues"
+}
+
+func (d *queuesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescQueuesID,
+			},
+			"queue_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: DescQueuesQueueType,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Execution", "Route"),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: DescQueuesEnabled,
+			},
+			"started": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: DescQueuesStarted,
+			},
+			"names": schema.ListAttribute{
+				Computed:            true,
+				MarkdownDescription: DescQueuesNames,
+				ElementType:         types.StringType,
+			},
+			"queues": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: DescQueuesQueues,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescQueueName,
+						},
+						"queue_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescQueueQtype,
+						},
+						"enabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: DescQueueEnabled,
+						},
+						"started": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: DescQueueStarted,
+						},
+						"priority": schema.Int32Attribute{
+							Computed:            true,
+							MarkdownDescription: DescQueuePriority,
+						},
+						"partition": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: DescQueuePartition,
+						},
+						"total_jobs": schema.Int32Attribute{
+							Computed:            true,
+							MarkdownDescription: DescQueueTotalJobs,
+						},
+						"state_count": schema.MapAttribute{
+							Computed:            true,
+							MarkdownDescription: DescQueueStateCount,
+							ElementType:         types.Int32Type,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *queuesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	data := queuesDataSourceModel{}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	all, err := d.client.GetQueues()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to connect to PBS server and get queue information", err.Error())
+		return
+	}
+
+	sort.Slice(all, func(i, j int) bool { return all[i].Name < all[j].Name })
+
+	data.ID = types.StringValue("queues")
+	data.Names = []types.String{}
+	data.Queues = []queuesEntryModel{}
+	for _, q := range all {
+		if !data.QueueType.IsNull() && q.QueueType != data.QueueType.ValueString() {
+			continue
+		}
+		if !data.Enabled.IsNull() && q.Enabled != data.Enabled.ValueBool() {
+			continue
+		}
+		if !data.Started.IsNull() && q.Started != data.Started.ValueBool() {
+			continue
+		}
+
+		entry := queuesEntryModel{
+			Name:       types.StringValue(q.Name),
+			QueueType:  types.StringValue(q.QueueType),
+			Enabled:    types.BoolValue(q.Enabled),
+			Started:    types.BoolValue(q.Started),
+			Priority:   types.Int32PointerValue(q.Priority),
+			Partition:  types.StringPointerValue(q.Partition),
+			TotalJobs:  types.Int32PointerValue(q.TotalJobs),
+			StateCount: convertStateCount(q.StateCount),
+		}
+
+		data.Names = append(data.Names, types.StringValue(q.Name))
+		data.Queues = append(data.Queues, entry)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *queuesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pbsclient.PbsClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *PbsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-pbs/internal/pbsclient"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type reservationModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Start      types.String `tfsdk:"start"`
+	End        types.String `tfsdk:"end"`
+	Duration   types.String `tfsdk:"duration"`
+	Select     types.String `tfsdk:"select"`
+	Recurrence types.String `tfsdk:"recurrence"`
+	Timezone   types.String `tfsdk:"timezone"`
+	Users      types.List   `tfsdk:"users"`
+	Queue      types.String `tfsdk:"queue"`
+	State      types.String `tfsdk:"state"`
+}
+
+// ToPbsReservation converts the model into a reservation. End and duration are only set when they are known, the
+// one which isn't configured is unknown in a plan so a value calculated by the server is never sent back.
+func (m reservationModel) ToPbsReservation() (pbsclient.PbsReservation, error) {
+	r := pbsclient.PbsReservation{
+		ID: m.ID.ValueString(),
+	}
+
+	start, err := time.Parse(time.RFC3339, m.Start.ValueString())
+	if err != nil {
+		return r, fmt.Errorf("start must be an RFC 3339 timestamp: %s", err)
+	}
+	r.Start = start
+
+	if !m.End.IsNull() && !m.End.IsUnknown() {
+		end, err := time.Parse(time.RFC3339, m.End.ValueString())
+		if err != nil {
+			return r, fmt.Errorf("end must be an RFC 3339 timestamp: %s", err)
+		}
+		r.End = end
+	}
+	if !m.Duration.IsNull() && !m.Duration.IsUnknown() {
+		duration, err := parseWalltime(m.Duration.ValueString())
+		if err != nil {
+			return r, err
+		}
+		r.Duration = duration
+	}
+
+	SetStringPointerIfNotNull(m.Name, &r.Name)
+	SetStringPointerIfNotNull(m.Select, &r.Select)
+	SetStringPointerIfNotNull(m.Recurrence, &r.Rrule)
+	SetStringPointerIfNotNull(m.Timezone, &r.Timezone)
+	if !m.Users.IsNull() && !m.Users.IsUnknown() {
+		users := make([]string, 0, len(m.Users.Elements()))
+		for _, u := range m.Users.Elements() {
+			if s, ok := u.(types.String); ok {
+				users = append(users, s.ValueString())
+			}
+		}
+		joined := strings.Join(users, ",")
+		r.AuthorizedUsers = &joined
+	}
+
+	return r, nil
+}
+
+// createReservationModel creates the model from a reservation read from the server. Times and the duration are
+// kept as written in prior when they are equivalent so that "2026-11-02T10:00:00+01:00" doesn't show up as a
+// change to "2026-11-02T09:00:00Z". The server reports the next occurrence of a standing reservation, so once
+// set its start and end are never taken from the server.
+func createReservationModel(r pbsclient.PbsReservation, prior reservationModel) reservationModel {
+	model := reservationModel{
+		ID:         types.StringValue(r.ID),
+		Name:       types.StringPointerValue(r.Name),
+		Select:     types.StringPointerValue(r.Select),
+		Recurrence: types.StringPointerValue(r.Rrule),
+		Timezone:   types.StringPointerValue(r.Timezone),
+		Queue:      types.StringPointerValue(r.Queue),
+		State:      types.StringPointerValue(r.State),
+	}
+
+	if model.Recurrence.IsNull() {
+		model.Recurrence = prior.Recurrence
+	}
+	if model.Timezone.IsNull() {
+		model.Timezone = prior.Timezone
+	}
+
+	keepPrior := r.IsStanding()
+	model.Start = preserveEquivalentTime(prior.Start, r.Start, keepPrior)
+	model.End = preserveEquivalentTime(prior.End, r.End, keepPrior)
+
+	model.Duration = types.StringValue(formatWalltime(r.Duration))
+	if !prior.Duration.IsNull() && !prior.Duration.IsUnknown() {
+		if d, err := parseWalltime(prior.Duration.ValueString()); err == nil && (d == r.Duration || keepPrior) {
+			model.Duration = prior.Duration
+		}
+	}
+
+	users := []attr.Value{}
+	if r.AuthorizedUsers != nil {
+		for _, u := range strings.Split(*r.AuthorizedUsers, ",") {
+			if u = strings.TrimSpace(u); u != "" {
+				users = append(users, types.StringValue(u))
+			}
+		}
+	}
+	model.Users = types.ListValueMust(types.StringType, users)
+
+	return model
+}
+
+// preserveEquivalentTime returns prior if it is the same instant as actual, or if keepPrior is set and prior has a
+// value, and otherwise actual formatted as an RFC 3339 timestamp.
+func preserveEquivalentTime(prior types.String, actual time.Time, keepPrior bool) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if t, err := time.Parse(time.RFC3339, prior.ValueString()); err == nil && (t.Equal(actual) || keepPrior) {
+			return prior
+		}
+	}
+
+	return types.StringValue(actual.UTC().Format(time.RFC3339))
+}
+
+// parseWalltime parses a duration written the way PBS writes walltime, [[hours:]minutes:]seconds, e.g. "01:30:00"
+// or "5400".
+func parseWalltime(value string) (time.Duration, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) > 3 {
+		return 0, fmt.Errorf("duration %q must be in the form [[hh:]mm:]ss", value)
+	}
+
+	var total int64
+	for _, part := range parts {
+		n, err := strconv.ParseInt(part, 10, 64)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("duration %q must be in the form [[hh:]mm:]ss", value)
+		}
+		total = total*60 + n
+	}
+
+	return time.Duration(total) * time.Second, nil
+}
+
+// formatWalltime formats a duration as hh:mm:ss.
+func formatWalltime(d time.Duration) string {
+	seconds := int64(d / time.Second)
+	return fmt.Sprintf("%02d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+}
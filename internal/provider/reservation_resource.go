@@ -0,0 +1,387 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"terraform-provider-pbs/internal/pbsclient"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &reservationResource{}
+	_ resource.ResourceWithConfigure      = &reservationResource{}
+	_ resource.ResourceWithImportState    = &reservationResource{}
+	_ resource.ResourceWithValidateConfig = &reservationResource{}
+)
+
+const (
+	reservationConfirmTimeout      = 2 * time.Minute
+	reservationConfirmPollInterval = 5 * time.Second
+)
+
+func NewReservationResource() resource.Resource {
+	return &reservationResource{}
+}
+
+// reservationResource manages an advance or standing reservation. It is created with pbs_rsub, changed in place
+// with pbs_ralter where PBS allows it and deleted with pbs_rdel.
+type reservationResource struct {
+	client *pbsclient.PbsClient
+}
+
+func (r *reservationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reservation"
+}
+
+func (r *reservationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescReservationID,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: DescReservationName,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"start": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: DescReservationStart,
+			},
+			"end": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: DescReservationEnd,
+			},
+			"duration": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: DescReservationDuration,
+			},
+			"select": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: DescReservationSelect,
+			},
+			"recurrence": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: DescReservationRecurrence,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: DescReservationTimezone,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.ListAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: DescReservationUsers,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"queue": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescReservationQueue,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescReservationState,
+			},
+		},
+	}
+}
+
+func (r *reservationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pbsclient.PbsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pbsclient.PbsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *reservationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data reservationModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateReservationTimes(data.Start, data.End, data.Duration)...)
+
+	if !data.Recurrence.IsUnknown() && !data.Timezone.IsUnknown() && data.Recurrence.IsNull() != data.Timezone.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("timezone"), "Invalid Standing Reservation", "recurrence and timezone must either both be set, for a standing reservation, or both be left out.")
+	}
+}
+
+// validateReservationTimes checks that start and end are RFC 3339 timestamps with end after start, that duration is
+// a valid walltime and that exactly one of end and duration is set.
+func validateReservationTimes(startValue types.String, endValue types.String, durationValue types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var start, end time.Time
+	var err error
+
+	if !startValue.IsNull() && !startValue.IsUnknown() {
+		if start, err = time.Parse(time.RFC3339, startValue.ValueString()); err != nil {
+			diags.AddAttributeError(path.Root("start"), "Invalid Start", "start must be an RFC 3339 timestamp, e.g. \"2026-11-02T09:00:00Z\".")
+		}
+	}
+	if !endValue.IsNull() && !endValue.IsUnknown() {
+		if end, err = time.Parse(time.RFC3339, endValue.ValueString()); err != nil {
+			diags.AddAttributeError(path.Root("end"), "Invalid End", "end must be an RFC 3339 timestamp, e.g. \"2026-11-02T17:00:00Z\".")
+		} else if !start.IsZero() && !end.After(start) {
+			diags.AddAttributeError(path.Root("end"), "Invalid End", "end must be after start.")
+		}
+	}
+	if !durationValue.IsNull() && !durationValue.IsUnknown() {
+		if d, err := parseWalltime(durationValue.ValueString()); err != nil {
+			diags.AddAttributeError(path.Root("duration"), "Invalid Duration", err.Error())
+		} else if d <= 0 {
+			diags.AddAttributeError(path.Root("duration"), "Invalid Duration", "duration must be longer than zero.")
+		}
+	}
+
+	if endValue.IsUnknown() || durationValue.IsUnknown() {
+		return diags
+	}
+	if endValue.IsNull() == durationValue.IsNull() {
+		diags.AddAttributeError(path.Root("end"), "Invalid Reservation Length", "Exactly one of end or duration must be set.")
+	}
+
+	return diags
+}
+
+func (r *reservationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model reservationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, err := model.ToPbsReservation()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Reservation", err.Error())
+		return
+	}
+
+	id, err := r.client.CreateReservation(desired)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating resource", fmt.Sprintf("Could not create reservation, unexpected error: %s", err))
+		return
+	}
+
+	reservation, found, err := waitForReservationConfirmation(ctx, r.client, id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read reservation %s, got error: %s", id, err))
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Reservation Denied", fmt.Sprintf("Reservation %s was denied by the scheduler, there are not enough free resources for select %s starting at %s (%s).", id, model.Select.ValueString(), model.Start.ValueString(), describeReservationLength(model)))
+		return
+	}
+	resp.Diagnostics.Append(unconfirmedReservationWarning(reservation)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createReservationModel(reservation, model))...)
+}
+
+func (r *reservationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state reservationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reservation, found, err := r.client.GetReservation(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read reservation %s, got error: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	// If the reservation has been deleted outside of terraform, or has finished, remove it from the state
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createReservationModel(reservation, state))...)
+}
+
+func (r *reservationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state reservationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := state.ToPbsReservation()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Reservation", err.Error())
+		return
+	}
+	desired, err := plan.ToPbsReservation()
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Reservation", err.Error())
+		return
+	}
+	desired.ID = current.ID
+
+	err = r.client.UpdateReservation(current, desired)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to alter the reservation. "+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	reservation, found, err := waitForReservationConfirmation(ctx, r.client, current.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read reservation %s, got error: %s", current.ID, err))
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError("Reservation Not Found", fmt.Sprintf("Reservation %s no longer exists.", current.ID))
+		return
+	}
+
+	// A change the scheduler can't fit is rejected and the reservation keeps its old times
+	if !reservation.IsStanding() && !reservationTimesMatch(reservation, desired) {
+		resp.Diagnostics.AddError("Reservation Change Denied", fmt.Sprintf("The scheduler could not fit reservation %s starting at %s (%s), it has been left from %s to %s.", current.ID, plan.Start.ValueString(), describeReservationLength(plan), reservation.Start.UTC().Format(time.RFC3339), reservation.End.UTC().Format(time.RFC3339)))
+		return
+	}
+	resp.Diagnostics.Append(unconfirmedReservationWarning(reservation)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createReservationModel(reservation, plan))...)
+}
+
+func (r *reservationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data reservationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteReservation(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete reservation %s, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+}
+
+func (r *reservationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the standard passthrough for ID, which will set both id and trigger a Read
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// waitForReservationConfirmation polls the reservation until the scheduler has confirmed or denied it. A denied
+// reservation is deleted by the server so the returned bool is false. If the scheduler hasn't decided within
+// reservationConfirmTimeout the reservation is returned as it is.
+func waitForReservationConfirmation(ctx context.Context, client *pbsclient.PbsClient, id string) (pbsclient.PbsReservation, bool, error) {
+	pending := []string{pbsclient.ReservationStateUnconfirmed, pbsclient.ReservationStateBeingAltered}
+	deadline := time.Now().Add(reservationConfirmTimeout)
+	for {
+		reservation, found, err := client.GetReservation(id)
+		if err != nil || !found {
+			return reservation, found, err
+		}
+		if reservation.State == nil || !slices.Contains(pending, *reservation.State) || !time.Now().Before(deadline) {
+			return reservation, true, nil
+		}
+
+		tflog.Info(ctx, "Waiting for the scheduler to confirm reservation", map[string]any{
+			"reservation": id,
+			"state":       *reservation.State,
+		})
+
+		select {
+		case <-ctx.Done():
+			return reservation, true, ctx.Err()
+		case <-time.After(reservationConfirmPollInterval):
+		}
+	}
+}
+
+// unconfirmedReservationWarning returns a warning if the scheduler hasn't yet confirmed the reservation.
+func unconfirmedReservationWarning(reservation pbsclient.PbsReservation) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if reservation.State != nil && *reservation.State == pbsclient.ReservationStateUnconfirmed {
+		diags.AddWarning(
+			"Reservation Not Yet Confirmed",
+			fmt.Sprintf("Reservation %s has not been confirmed by the scheduler after %s. If it is denied it will be removed from the state on the next refresh.", reservation.ID, reservationConfirmTimeout),
+		)
+	}
+	return diags
+}
+
+// reservationTimesMatch reports whether the reservation has the start and end or duration that were asked for.
+func reservationTimesMatch(reservation pbsclient.PbsReservation, desired pbsclient.PbsReservation) bool {
+	if !reservation.Start.Equal(desired.Start) {
+		return false
+	}
+	if !desired.End.IsZero() {
+		return reservation.End.Equal(desired.End)
+	}
+	return reservation.Duration == desired.Duration
+}
+
+// describeReservationLength describes the configured end or duration of the reservation for a diagnostic.
+func describeReservationLength(model reservationModel) string {
+	if !model.End.IsNull() && !model.End.IsUnknown() {
+		return "ending at " + model.End.ValueString()
+	}
+	return "lasting " + model.Duration.ValueString()
+}
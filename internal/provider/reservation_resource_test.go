@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"terraform-provider-pbs/internal/pbsclient"
+)
+
+func TestParseWalltime(t *testing.T) {
+	tests := map[string]time.Duration{
+		"01:30:00": 90 * time.Minute,
+		"90:00":    90 * time.Minute,
+		"5400":     90 * time.Minute,
+		"48:00:00": 48 * time.Hour,
+	}
+	for value, want := range tests {
+		got, err := parseWalltime(value)
+		if err != nil {
+			t.Errorf("unexpected error for %q: %s", value, err)
+		}
+		if got != want {
+			t.Errorf("got %s, wanted %s", got, want)
+		}
+	}
+
+	for _, value := range []string{"", "1h", "1:2:3:4", "-5"} {
+		if _, err := parseWalltime(value); err == nil {
+			t.Errorf("expected an error for %q", value)
+		}
+	}
+
+	if got, want := formatWalltime(48*time.Hour+90*time.Second), "48:01:30"; got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func TestCreateReservationModelPreservesEquivalentValues(t *testing.T) {
+	start := time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC)
+	users := "alice,bob"
+	reservation := pbsclient.PbsReservation{
+		ID:              "R12.pbs",
+		Start:           start,
+		End:             start.Add(90 * time.Minute),
+		Duration:        90 * time.Minute,
+		AuthorizedUsers: &users,
+	}
+
+	model := createReservationModel(reservation, reservationModel{
+		Start:    types.StringValue("2026-11-02T10:00:00+01:00"),
+		Duration: types.StringValue("90:00"),
+	})
+	if got, want := model.Start.ValueString(), "2026-11-02T10:00:00+01:00"; got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+	if got, want := model.Duration.ValueString(), "90:00"; got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+	if got, want := model.End.ValueString(), "2026-11-02T10:30:00Z"; got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+	if got := len(model.Users.Elements()); got != 2 {
+		t.Errorf("expected 2 users but got %d", got)
+	}
+
+	// A change made outside of terraform is reported
+	model = createReservationModel(reservation, reservationModel{
+		Start: types.StringValue("2026-11-02T08:00:00Z"),
+	})
+	if got, want := model.Start.ValueString(), "2026-11-02T09:00:00Z"; got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func TestAccReservationResource_basic(t *testing.T) {
+	start := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Hour)
+	startText := start.Format(time.RFC3339)
+	endText := start.Add(2 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReservationResourceConfig(startText, `duration = "01:00:00"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("pbs_reservation.test", "id", regexp.MustCompile(`^R\d+\.`)),
+					resource.TestMatchResourceAttr("pbs_reservation.test", "queue", regexp.MustCompile(`^R\d+$`)),
+					resource.TestCheckResourceAttr("pbs_reservation.test", "state", "RESV_CONFIRMED"),
+					resource.TestCheckResourceAttr("pbs_reservation.test", "start", startText),
+					resource.TestCheckResourceAttr("pbs_reservation.test", "duration", "01:00:00"),
+					resource.TestCheckResourceAttr("pbs_reservation.test", "end", start.Add(time.Hour).Format(time.RFC3339)),
+				),
+			},
+			{
+				ResourceName:            "pbs_reservation.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"state"},
+			},
+			// Extending the reservation is done in place with pbs_ralter
+			{
+				Config: testAccReservationResourceConfig(startText, fmt.Sprintf("end = %q", endText)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_reservation.test", "end", endText),
+					resource.TestCheckResourceAttr("pbs_reservation.test", "duration", "02:00:00"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccReservationResource_invalidLength(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccReservationResourceConfig("2026-11-02T09:00:00Z", ""),
+				ExpectError: regexp.MustCompile(`Exactly one of end or duration must be set`),
+			},
+		},
+	})
+}
+
+func testAccReservationResourceConfig(start string, length string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_reservation" "test" {
+  name   = "tfacc"
+  start  = %[1]q
+  select = "1:ncpus=1"
+  %[2]s
+}
+`, start, length)
+}
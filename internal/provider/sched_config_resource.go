@@ -0,0 +1,369 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"terraform-provider-pbs/internal/pbsclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &schedConfigResource{}
+	_ resource.ResourceWithConfigure   = &schedConfigResource{}
+	_ resource.ResourceWithImportState = &schedConfigResource{}
+)
+
+var (
+	absolutePathRegex     = regexp.MustCompile(`^/[^'"\s]*$`)
+	schedConfigTokenRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_\-]*$`)
+	schedConfigValueRegex = regexp.MustCompile(`^[^"\n]+$`)
+)
+
+// schedConfigManagedOptions are the options with their own attribute which can't also be set through options.
+var schedConfigManagedOptions = []string{"resources", "fairshare_usage_res", "backfill_prime", "strict_ordering", "by_queue", "peer_queue"}
+
+func NewSchedConfigResource() resource.Resource {
+	return &schedConfigResource{}
+}
+
+// schedConfigResource manages options in the scheduler's sched_config file. Only the options that are set are
+// changed, every other line of the file including its comments is left exactly as it was.
+type schedConfigResource struct {
+	client *pbsclient.PbsClient
+}
+
+type schedConfigModel struct {
+	ID                types.String            `tfsdk:"id"`
+	Path              types.String            `tfsdk:"path"`
+	Resources         []types.String          `tfsdk:"resources"`
+	FairshareUsageRes types.String            `tfsdk:"fairshare_usage_res"`
+	BackfillPrime     types.Bool              `tfsdk:"backfill_prime"`
+	StrictOrdering    types.Bool              `tfsdk:"strict_ordering"`
+	ByQueue           types.Bool              `tfsdk:"by_queue"`
+	PeerQueues        []types.String          `tfsdk:"peer_queues"`
+	Options           map[string]types.String `tfsdk:"options"`
+}
+
+// boolOptions returns the boolean attributes keyed by their sched_config option.
+func (m *schedConfigModel) boolOptions() map[string]*types.Bool {
+	return map[string]*types.Bool{
+		"backfill_prime":  &m.BackfillPrime,
+		"strict_ordering": &m.StrictOrdering,
+		"by_queue":        &m.ByQueue,
+	}
+}
+
+func (r *schedConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sched_config"
+}
+
+func (r *schedConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescSchedConfigID,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(pbsclient.DefaultSchedConfigPath),
+				MarkdownDescription: DescSchedConfigPath,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(absolutePathRegex, "must be an absolute path"),
+				},
+			},
+			"resources": schema.ListAttribute{
+				Optional:            true,
+				MarkdownDescription: DescSchedConfigResources,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(schedConfigTokenRegex, "must be a resource name")),
+				},
+			},
+			"fairshare_usage_res": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: DescSchedConfigFairshareUsageRes,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(schedConfigValueRegex, "must be a single line without double quotes"),
+				},
+			},
+			"backfill_prime": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: DescSchedConfigBackfillPrime,
+			},
+			"strict_ordering": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: DescSchedConfigStrictOrdering,
+			},
+			"by_queue": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: DescSchedConfigByQueue,
+			},
+			"peer_queues": schema.ListAttribute{
+				Optional:            true,
+				MarkdownDescription: DescSchedConfigPeerQueues,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.RegexMatches(schedConfigValueRegex, "must be a single line without double quotes")),
+				},
+			},
+			"options": schema.MapAttribute{
+				Optional:            true,
+				MarkdownDescription: DescSchedConfigOptions,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(schedConfigTokenRegex, "must be a sched_config option name"),
+						stringvalidator.NoneOf(schedConfigManagedOptions...),
+					),
+					mapvalidator.ValueStringsAre(stringvalidator.RegexMatches(schedConfigValueRegex, "must be a single line without double quotes")),
+				},
+			},
+		},
+	}
+}
+
+func (r *schedConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pbsclient.PbsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pbsclient.PbsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *schedConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model schedConfigModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.apply(model, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating resource", fmt.Sprintf("Could not update %s, unexpected error: %s", model.Path.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+}
+
+func (r *schedConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state schedConfigModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// For import, the ID is the path of the file
+	if state.Path.IsNull() {
+		state.Path = state.ID
+	}
+
+	config, err := r.client.GetSchedConfig(state.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s, got error: %s", state.Path.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createSchedConfigModel(config, state))...)
+}
+
+func (r *schedConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan schedConfigModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.apply(plan, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the scheduler configuration. "+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+}
+
+func (r *schedConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The options are left as they are, removing them would change the scheduling policy back to the built in
+	// defaults rather than to what was in the file before
+	resp.Diagnostics.AddWarning(
+		"Scheduler Configuration Not Changed",
+		"The scheduler configuration has been removed from Terraform state but sched_config is unchanged.",
+	)
+}
+
+func (r *schedConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the standard passthrough for ID, which will set both id and trigger a Read
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply writes the configured options to sched_config and reloads the scheduler if anything changed.
+func (r *schedConfigResource) apply(model schedConfigModel, diags *diag.Diagnostics) (schedConfigModel, error) {
+	config, err := r.client.EditSchedConfig(model.Path.ValueString(), func(config *pbsclient.SchedConfig) {
+		applySchedConfigModel(config, model)
+	})
+	if err = warnIfSchedulerNotRunning(diags, err); err != nil {
+		return model, err
+	}
+
+	return createSchedConfigModel(config, model), nil
+}
+
+// warnIfSchedulerNotRunning adds a warning rather than an error when a scheduler file was written but pbs_sched
+// isn't running to re-read it. Any other error is returned unchanged.
+func warnIfSchedulerNotRunning(diags *diag.Diagnostics, err error) error {
+	if !errors.Is(err, pbsclient.ErrSchedulerNotRunning) {
+		return err
+	}
+
+	diags.AddWarning("Scheduler Not Running", "The file was updated but pbs_sched isn't running to re-read it, the change takes effect when pbs_sched is next started.")
+	return nil
+}
+
+// applySchedConfigModel sets every option which has a value in the model. Options which aren't set are left alone.
+func applySchedConfigModel(config *pbsclient.SchedConfig, model schedConfigModel) {
+	if model.Resources != nil {
+		names := make([]string, 0, len(model.Resources))
+		for _, name := range model.Resources {
+			names = append(names, name.ValueString())
+		}
+		config.Set("resources", []pbsclient.SchedConfigValue{{Value: strings.Join(names, ", ")}})
+	}
+	if !model.FairshareUsageRes.IsNull() {
+		config.Set("fairshare_usage_res", []pbsclient.SchedConfigValue{{Value: model.FairshareUsageRes.ValueString()}})
+	}
+	for option, value := range model.boolOptions() {
+		if !value.IsNull() {
+			config.Set(option, []pbsclient.SchedConfigValue{{Value: fmt.Sprintf("%t", value.ValueBool()), Prime: "ALL"}})
+		}
+	}
+	if model.PeerQueues != nil {
+		values := make([]pbsclient.SchedConfigValue, 0, len(model.PeerQueues))
+		for _, q := range model.PeerQueues {
+			values = append(values, pbsclient.SchedConfigValue{Value: q.ValueString()})
+		}
+		config.Set("peer_queue", values)
+	}
+	for option, value := range model.Options {
+		config.Set(option, []pbsclient.SchedConfigValue{pbsclient.ParseSchedConfigValue(value.ValueString())})
+	}
+}
+
+// createSchedConfigModel reads the options managed by prior from the file. Options that prior doesn't set are left
+// null so that the rest of the file isn't taken over. An option is read as null if the file doesn't match the
+// form this resource writes, e.g. by_queue set differently for prime and non-prime time, so that it is planned
+// as a change.
+func createSchedConfigModel(config pbsclient.SchedConfig, prior schedConfigModel) schedConfigModel {
+	model := schedConfigModel{
+		ID:                prior.Path,
+		Path:              prior.Path,
+		FairshareUsageRes: types.StringNull(),
+		BackfillPrime:     types.BoolNull(),
+		StrictOrdering:    types.BoolNull(),
+		ByQueue:           types.BoolNull(),
+	}
+
+	if prior.Resources != nil {
+		if values := config.Values("resources"); len(values) == 1 {
+			model.Resources = []types.String{}
+			for _, name := range strings.Split(values[0].Value, ",") {
+				if name = strings.TrimSpace(name); name != "" {
+					model.Resources = append(model.Resources, types.StringValue(name))
+				}
+			}
+		}
+	}
+	if !prior.FairshareUsageRes.IsNull() {
+		if values := config.Values("fairshare_usage_res"); len(values) == 1 {
+			model.FairshareUsageRes = types.StringValue(values[0].Value)
+		}
+	}
+	priorBools := prior.boolOptions()
+	for option, target := range model.boolOptions() {
+		if !priorBools[option].IsNull() {
+			*target = readSchedConfigBool(config.Values(option))
+		}
+	}
+	if prior.PeerQueues != nil {
+		model.PeerQueues = []types.String{}
+		for _, v := range config.Values("peer_queue") {
+			model.PeerQueues = append(model.PeerQueues, types.StringValue(v.Value))
+		}
+	}
+	if prior.Options != nil {
+		model.Options = map[string]types.String{}
+		for option, priorValue := range prior.Options {
+			values := config.Values(option)
+			if len(values) != 1 {
+				continue
+			}
+			// Keep the configured spelling if it means the same, e.g. "true  ALL" and "true ALL"
+			if pbsclient.ParseSchedConfigValue(priorValue.ValueString()) == values[0] {
+				model.Options[option] = priorValue
+			} else {
+				model.Options[option] = types.StringValue(strings.TrimSpace(values[0].Value + " " + values[0].Prime))
+			}
+		}
+	}
+
+	return model
+}
+
+// readSchedConfigBool returns the value of a boolean option, or null unless it is set once for ALL of prime and
+// non-prime time.
+func readSchedConfigBool(values []pbsclient.SchedConfigValue) types.Bool {
+	if len(values) != 1 || !strings.EqualFold(values[0].Prime, "all") {
+		return types.BoolNull()
+	}
+
+	switch strings.ToLower(values[0].Value) {
+	case "true":
+		return types.BoolValue(true)
+	case "false":
+		return types.BoolValue(false)
+	}
+
+	return types.BoolNull()
+}
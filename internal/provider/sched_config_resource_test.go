@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"fmt"
+	"terraform-provider-pbs/internal/pbsclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testSchedConfig = `# the scheduler's configuration
+round_robin: False	all
+by_queue: True		prime
+by_queue: True		non_prime
+
+# resources the scheduler checks
+resources: "ncpus, mem, arch, host, vnode, aoe, eoe"
+smp_cluster_dist: pack
+`
+
+func TestApplySchedConfigModel(t *testing.T) {
+	config := pbsclient.ParseSchedConfig(testSchedConfig)
+	applySchedConfigModel(&config, schedConfigModel{
+		Resources:      []types.String{types.StringValue("ncpus"), types.StringValue("mem"), types.StringValue("ngpus")},
+		StrictOrdering: types.BoolValue(true),
+		ByQueue:        types.BoolValue(false),
+		Options:        map[string]types.String{"smp_cluster_dist": types.StringValue("lowest_load ALL")},
+	})
+
+	wanted := `# the scheduler's configuration
+round_robin: False	all
+by_queue: false	ALL
+
+# resources the scheduler checks
+resources: "ncpus, mem, ngpus"
+smp_cluster_dist: lowest_load	ALL
+strict_ordering: true	ALL
+`
+	if got := config.String(); got != wanted {
+		t.Errorf("got %q, wanted %q", got, wanted)
+	}
+}
+
+func TestCreateSchedConfigModel(t *testing.T) {
+	config := pbsclient.ParseSchedConfig(testSchedConfig)
+	prior := schedConfigModel{
+		Path:      types.StringValue(pbsclient.DefaultSchedConfigPath),
+		Resources: []types.String{},
+		ByQueue:   types.BoolValue(true),
+		Options: map[string]types.String{
+			"round_robin":      types.StringValue("False  all"),
+			"smp_cluster_dist": types.StringValue("round_robin"),
+			"help_starving":    types.StringValue("true ALL"),
+		},
+	}
+
+	model := createSchedConfigModel(config, prior)
+
+	if got := len(model.Resources); got != 7 {
+		t.Fatalf("got %d resources, wanted 7", got)
+	}
+	if got := model.Resources[6].ValueString(); got != "eoe" {
+		t.Errorf("got %q, wanted %q", got, "eoe")
+	}
+	// by_queue is set separately for prime and non-prime time so can't be read as one value
+	if !model.ByQueue.IsNull() {
+		t.Errorf("got %s, wanted null by_queue", model.ByQueue)
+	}
+	if !model.StrictOrdering.IsNull() {
+		t.Errorf("got %s, wanted null strict_ordering", model.StrictOrdering)
+	}
+	if got := model.Options["round_robin"].ValueString(); got != "False  all" {
+		t.Errorf("got %q, wanted %q", got, "False  all")
+	}
+	if got := model.Options["smp_cluster_dist"].ValueString(); got != "pack" {
+		t.Errorf("got %q, wanted %q", got, "pack")
+	}
+	if _, ok := model.Options["help_starving"]; ok {
+		t.Errorf("got help_starving, wanted it to be missing")
+	}
+	if got := model.ID.ValueString(); got != pbsclient.DefaultSchedConfigPath {
+		t.Errorf("got %q, wanted %q", got, pbsclient.DefaultSchedConfigPath)
+	}
+}
+
+func TestAccSchedConfigResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchedConfigResourceConfig("false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_sched_config.test", "id", pbsclient.DefaultSchedConfigPath),
+					resource.TestCheckResourceAttr("pbs_sched_config.test", "strict_ordering", "false"),
+					resource.TestCheckResourceAttr("pbs_sched_config.test", "resources.#", "6"),
+					resource.TestCheckResourceAttr("pbs_sched_config.test", "options.smp_cluster_dist", "pack ALL"),
+				),
+			},
+			{
+				Config: testAccSchedConfigResourceConfig("true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_sched_config.test", "strict_ordering", "true"),
+				),
+			},
+			// Leave the scheduler as it was
+			{
+				Config: testAccSchedConfigResourceConfig("false"),
+			},
+		},
+	})
+}
+
+func testAccSchedConfigResourceConfig(strictOrdering string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_sched_config" "test" {
+  resources       = ["ncpus", "mem", "arch", "host", "vnode", "aoe"]
+  strict_ordering = %[1]s
+
+  options = {
+    smp_cluster_dist = "pack ALL"
+  }
+}
+`, strictOrdering)
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-pbs/internal/pbsclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &serverAclEntryResource{}
+	_ resource.ResourceWithConfigure   = &serverAclEntryResource{}
+	_ resource.ResourceWithImportState = &serverAclEntryResource{}
+)
+
+func NewServerAclEntryResource() resource.Resource {
+	return &serverAclEntryResource{}
+}
+
+// serverAclEntryResource owns exactly one entry in one of the server ACLs, the server equivalent of
+// queueAclEntryResource.
+type serverAclEntryResource struct {
+	client *pbsclient.PbsClient
+}
+
+type serverAclEntryModel struct {
+	ID    types.String `tfsdk:"id"`
+	Acl   types.String `tfsdk:"acl"`
+	Entry types.String `tfsdk:"entry"`
+}
+
+func (r *serverAclEntryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_acl_entry"
+}
+
+func (r *serverAclEntryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescServerAclEntryID,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"acl": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: DescServerAclEntryAcl,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("acl_hosts", "acl_roots", "acl_users", "acl_resv_groups", "acl_resv_hosts", "acl_resv_users"),
+				},
+			},
+			"entry": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: DescAclEntryEntry,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(aclEntryRegex, "must be a single ACL entry with an optional + or - prefix"),
+				},
+			},
+		},
+	}
+}
+
+func (r *serverAclEntryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pbsclient.PbsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pbsclient.PbsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *serverAclEntryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model serverAclEntryModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.AddServerListEntry(model.Acl.ValueString(), model.Entry.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating resource", fmt.Sprintf("Could not add %s to server %s, unexpected error: %s", model.Entry.ValueString(), model.Acl.ValueString(), err))
+		return
+	}
+
+	model.ID = types.StringValue(model.Acl.ValueString() + "/" + model.Entry.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *serverAclEntryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverAclEntryModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// For import, split the ID into its parts
+	if state.Acl.IsNull() && !state.ID.IsNull() {
+		parts := strings.SplitN(state.ID.ValueString(), "/", 2)
+		if len(parts) != 2 {
+			resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected an ID of the form <acl>/<entry>, got: %s", state.ID.ValueString()))
+			return
+		}
+		state.Acl = types.StringValue(parts[0])
+		state.Entry = types.StringValue(parts[1])
+	}
+
+	entries, err := r.client.GetServerListEntries(state.Acl.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read server %s, got error: %s", state.Acl.ValueString(), err))
+		return
+	}
+
+	// If the entry has been removed outside of terraform, remove it from the state
+	if _, ok := pbsclient.FindListEntry(entries, state.Entry.ValueString()); !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(state.Acl.ValueString() + "/" + state.Entry.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *serverAclEntryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute requires replacement so there is nothing to do on the server
+	var model serverAclEntryModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.ID = types.StringValue(model.Acl.ValueString() + "/" + model.Entry.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *serverAclEntryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data serverAclEntryModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveServerListEntry(data.Acl.ValueString(), data.Entry.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove %s from server %s, got error: %s", data.Entry.ValueString(), data.Acl.ValueString(), err))
+		return
+	}
+}
+
+func (r *serverAclEntryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the standard passthrough for ID, which will set both id and trigger a Read
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServerAclEntryResource_basic(t *testing.T) {
+	entry := "+" + testAccResourceName("resv") + "@*"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerAclEntryResourceConfig("acl_resv_users", entry),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_server_acl_entry.test", "id", "acl_resv_users/"+entry),
+					resource.TestCheckResourceAttrWith("data.pbs_server.test", "acl_resv_users", testAccCheckListContains(entry)),
+				),
+			},
+			{
+				ResourceName:      "pbs_server_acl_entry.test",
+				ImportState:       true,
+				ImportStateId:     "acl_resv_users/" + entry,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccServerAclEntryResourceConfig(acl, entry string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_server_acl_entry" "test" {
+  acl   = %[1]q
+  entry = %[2]q
+}
+
+data "pbs_server" "test" {
+  name       = "pbs"
+  depends_on = [pbs_server_acl_entry.test]
+}
+`, acl, entry)
+}
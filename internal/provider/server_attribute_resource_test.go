@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServerAttributeResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerAttributeResourceConfig("queued_jobs_threshold", "", "[o:PBS_ALL=1000]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_server_attribute.test", "id", "queued_jobs_threshold"),
+					resource.TestCheckResourceAttr("pbs_server_attribute.test", "value", "[o:PBS_ALL=1000]"),
+				),
+			},
+			{
+				ResourceName:      "pbs_server_attribute.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServerAttributeResourceConfig("queued_jobs_threshold", "", "[o:PBS_ALL=2000]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_server_attribute.test", "value", "[o:PBS_ALL=2000]"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServerAttributeResource_resource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerAttributeResourceConfig("resources_max", "ncpus", "64"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_server_attribute.test", "id", "resources_max.ncpus"),
+					resource.TestCheckResourceAttr("pbs_server_attribute.test", "name", "resources_max"),
+					resource.TestCheckResourceAttr("pbs_server_attribute.test", "resource", "ncpus"),
+					resource.TestCheckResourceAttr("pbs_server_attribute.test", "value", "64"),
+				),
+			},
+			{
+				ResourceName:      "pbs_server_attribute.test",
+				ImportState:       true,
+				ImportStateId:     "resources_max.ncpus",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccServerAttributeResourceConfig(name, res, value string) string {
+	resourceLine := ""
+	if res != "" {
+		resourceLine = fmt.Sprintf("resource = %q", res)
+	}
+
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_server_attribute" "test" {
+  name  = %[1]q
+  %[2]s
+  value = %[3]q
+}
+`, name, resourceLine, value)
+}
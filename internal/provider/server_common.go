@@ -106,16 +106,16 @@ func (m serverModel) ToPbsServer(ctx context.Context) pbsclient.PbsServer {
 	// Set pointer fields using utility functions for null checking
 	SetBoolPointerIfNotNull(m.AclHostEnable, &server.AclHostEnable)
 	SetBoolPointerIfNotNull(m.AclHostMomsEnable, &server.AclHostMomsEnable)
-	SetStringPointerIfNotNull(m.AclHosts, &server.AclHosts)
+	SetListPointerIfNotEmpty(m.AclHosts, &server.AclHosts)
 	SetBoolPointerIfNotNull(m.AclResvGroupEnable, &server.AclResvGroupEnable)
-	SetStringPointerIfNotNull(m.AclResvGroups, &server.AclResvGroups)
+	SetListPointerIfNotEmpty(m.AclResvGroups, &server.AclResvGroups)
 	SetBoolPointerIfNotNull(m.AclResvHostEnable, &server.AclResvHostEnable)
-	SetStringPointerIfNotNull(m.AclResvHosts, &server.AclResvHosts)
+	SetListPointerIfNotEmpty(m.AclResvHosts, &server.AclResvHosts)
 	SetBoolPointerIfNotNull(m.AclResvUserEnable, &server.AclResvUserEnable)
-	SetStringPointerIfNotNull(m.AclResvUsers, &server.AclResvUsers)
-	SetStringPointerIfNotNull(m.AclRoots, &server.AclRoots)
+	SetListPointerIfNotEmpty(m.AclResvUsers, &server.AclResvUsers)
+	SetListPointerIfNotEmpty(m.AclRoots, &server.AclRoots)
 	SetBoolPointerIfNotNull(m.AclUserEnable, &server.AclUserEnable)
-	SetStringPointerIfNotNull(m.AclUsers, &server.AclUsers)
+	SetListPointerIfNotEmpty(m.AclUsers, &server.AclUsers)
 	SetInt32PointerIfNotNull(m.BackfillDepth, &server.BackfillDepth)
 	SetStringPointerIfNotNull(m.Comment, &server.Comment)
 	SetStringPointerIfNotNull(m.DefaultQdelArguments, &server.DefaultQdelArguments)
@@ -132,7 +132,7 @@ func (m serverModel) ToPbsServer(ctx context.Context) pbsclient.PbsServer {
 	SetInt32PointerIfNotNull(m.LogEvents, &server.LogEvents)
 	SetStringPointerIfNotNull(m.Mailer, &server.Mailer)
 	SetStringPointerIfNotNull(m.MailFrom, &server.MailFrom)
-	SetStringPointerIfNotNull(m.Managers, &server.Managers)
+	SetListPointerIfNotEmpty(m.Managers, &server.Managers)
 	SetInt32PointerIfNotNull(m.MaxArraySize, &server.MaxArraySize)
 	SetInt32PointerIfNotNull(m.MaxConcurrentProvision, &server.MaxConcurrentProvision)
 	SetInt32PointerIfNotNull(m.MaxGroupRun, &server.MaxGroupRun)
@@ -147,7 +147,7 @@ func (m serverModel) ToPbsServer(ctx context.Context) pbsclient.PbsServer {
 	SetInt32PointerIfNotNull(m.NodeFailRequeue, &server.NodeFailRequeue)
 	SetBoolPointerIfNotNull(m.NodeGroupEnable, &server.NodeGroupEnable)
 	SetStringPointerIfNotNull(m.NodeGroupKey, &server.NodeGroupKey)
-	SetStringPointerIfNotNull(m.Operators, &server.Operators)
+	SetListPointerIfNotEmpty(m.Operators, &server.Operators)
 	SetStringPointerIfNotNull(m.PbsLicenseInfo, &server.PbsLicenseInfo)
 	SetInt32PointerIfNotNull(m.PbsLicenseLingerTime, &server.PbsLicenseLingerTime)
 	SetInt32PointerIfNotNull(m.PbsLicenseMax, &server.PbsLicenseMax)
@@ -192,6 +192,56 @@ func (m serverModel) ToPbsServer(ctx context.Context) pbsclient.PbsServer {
 	return server
 }
 
+// serverResourceModel extends the server model shared with the data source with the settings that only apply to
+// the resource.
+type serverResourceModel struct {
+	serverModel
+	IgnoreAttributes []types.String `tfsdk:"ignore_attributes"`
+}
+
+func createServerResourceModel(server pbsclient.PbsServer, prior serverResourceModel) serverResourceModel {
+	return serverResourceModel{
+		serverModel:      createServerModel(server),
+		IgnoreAttributes: prior.IgnoreAttributes,
+	}
+}
+
+// serverDataSourceModel extends the server model shared with the resource with the runtime status reported by
+// the server, which can only be read.
+type serverDataSourceModel struct {
+	serverModel
+	ServerState       types.String            `tfsdk:"server_state"`
+	ServerHost        types.String            `tfsdk:"server_host"`
+	TotalJobs         types.Int32             `tfsdk:"total_jobs"`
+	StateCount        map[string]types.Int32  `tfsdk:"state_count"`
+	PbsVersion        types.String            `tfsdk:"pbs_version"`
+	LicenseCount      map[string]types.Int64  `tfsdk:"license_count"`
+	ResourcesAssigned map[string]types.String `tfsdk:"resources_assigned"`
+}
+
+func createServerDataSourceModel(server pbsclient.PbsServer) serverDataSourceModel {
+	model := serverDataSourceModel{
+		serverModel: createServerModel(server),
+		ServerState: types.StringPointerValue(server.ServerState),
+		ServerHost:  types.StringPointerValue(server.ServerHost),
+		TotalJobs:   types.Int32PointerValue(server.TotalJobs),
+		StateCount:  convertStateCount(server.StateCount),
+		PbsVersion:  types.StringPointerValue(server.PbsVersion),
+	}
+
+	if server.LicenseCount != nil {
+		model.LicenseCount = make(map[string]types.Int64, len(server.LicenseCount))
+		for name, count := range server.LicenseCount {
+			model.LicenseCount[name] = types.Int64Value(int64(count))
+		}
+	}
+	if len(server.ResourcesAssigned) > 0 {
+		model.ResourcesAssigned = convertStringMapToTypesStringMap(server.ResourcesAssigned)
+	}
+
+	return model
+}
+
 func createServerModel(server pbsclient.PbsServer) serverModel {
 	model := serverModel{
 		ID:   types.StringValue(server.Name), // Use name as ID
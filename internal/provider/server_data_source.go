@@ -408,12 +408,43 @@ func (d *serverDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed:            true,
 				MarkdownDescription: DescServerWebapiOidcProviderUrl,
 			},
+			"server_state": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescServerServerState,
+			},
+			"server_host": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescServerServerHost,
+			},
+			"total_jobs": schema.Int32Attribute{
+				Computed:            true,
+				MarkdownDescription: DescServerTotalJobs,
+			},
+			"state_count": schema.MapAttribute{
+				Computed:            true,
+				MarkdownDescription: DescServerStateCount,
+				ElementType:         types.Int32Type,
+			},
+			"pbs_version": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescServerPbsVersion,
+			},
+			"license_count": schema.MapAttribute{
+				Computed:            true,
+				MarkdownDescription: DescServerLicenseCount,
+				ElementType:         types.Int64Type,
+			},
+			"resources_assigned": schema.MapAttribute{
+				Computed:            true,
+				MarkdownDescription: DescServerResourcesAssigned,
+				ElementType:         types.StringType,
+			},
 		},
 	}
 }
 
 func (d *serverDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	sourceData := serverModel{}
+	sourceData := serverDataSourceModel{}
 	resp.Diagnostics.Append(req.Config.Get(ctx, &sourceData)...)
 
 	resultData, err := d.client.GetPbsServer(sourceData.Name.ValueString())
@@ -422,7 +453,7 @@ func (d *serverDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	serverModel := createServerModel(resultData)
+	serverModel := createServerDataSourceModel(resultData)
 
 	diag := resp.State.Set(ctx, &serverModel)
 	resp.Diagnostics.Append(diag...)
@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"terraform-provider-pbs/internal/pbsclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &serverMembershipResource{}
+	_ resource.ResourceWithConfigure   = &serverMembershipResource{}
+	_ resource.ResourceWithImportState = &serverMembershipResource{}
+)
+
+var userAtHostRegex = regexp.MustCompile(`^[^@,\s'"]+@[^@,\s'"]+$`)
+
+// NewServerManagerResource manages a single entry in the server managers list.
+func NewServerManagerResource() resource.Resource {
+	return &serverMembershipResource{
+		typeSuffix:      "_server_manager",
+		attribute:       "managers",
+		idDescription:   DescServerManagerID,
+		userDescription: DescServerManagerUser,
+	}
+}
+
+// NewServerOperatorResource manages a single entry in the server operators list.
+func NewServerOperatorResource() resource.Resource {
+	return &serverMembershipResource{
+		typeSuffix:      "_server_operator",
+		attribute:       "operators",
+		idDescription:   DescServerOperatorID,
+		userDescription: DescServerOperatorUser,
+	}
+}
+
+// serverMembershipResource owns exactly one user@host entry in a list valued server attribute. Entries
+// are added and removed with qmgr's += and -= operators so that several of these resources (and entries
+// managed outside of terraform) can coexist in the same list.
+type serverMembershipResource struct {
+	client          *pbsclient.PbsClient
+	typeSuffix      string
+	attribute       string
+	idDescription   string
+	userDescription string
+}
+
+type serverMembershipModel struct {
+	ID   types.String `tfsdk:"id"`
+	User types.String `tfsdk:"user"`
+}
+
+func (r *serverMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.typeSuffix
+}
+
+func (r *serverMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: r.idDescription,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: r.userDescription,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(userAtHostRegex, "must be in the form user@host"),
+				},
+			},
+		},
+	}
+}
+
+func (r *serverMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pbsclient.PbsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pbsclient.PbsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *serverMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model serverMembershipModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.AddServerListEntry(r.attribute, model.User.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating resource", fmt.Sprintf("Could not add %s to server %s, unexpected error: %s", model.User.ValueString(), r.attribute, err))
+		return
+	}
+
+	model.ID = model.User
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *serverMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state serverMembershipModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// For import, use ID if user is not set
+	user := state.User.ValueString()
+	if user == "" && !state.ID.IsNull() {
+		user = state.ID.ValueString()
+	}
+
+	entries, err := r.client.GetServerListEntries(r.attribute)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read server %s, got error: %s", r.attribute, err))
+		return
+	}
+
+	// If the entry has been removed outside of terraform, remove it from the state
+	if !slices.Contains(entries, user) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	updatedState := serverMembershipModel{
+		ID:   types.StringValue(user),
+		User: types.StringValue(user),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedState)...)
+}
+
+func (r *serverMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// The only configurable attribute requires replacement so there is nothing to do on the server
+	var model serverMembershipModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model.ID = model.User
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *serverMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data serverMembershipModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RemoveServerListEntry(r.attribute, data.User.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove %s from server %s, got error: %s", data.User.ValueString(), r.attribute, err))
+		return
+	}
+}
+
+func (r *serverMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the standard passthrough for ID, which will set both id and trigger a Read
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
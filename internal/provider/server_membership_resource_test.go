@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServerManagerResource_basic(t *testing.T) {
+	user := testAccResourceName("mgr") + "@*"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerMembershipResourceConfig("pbs_server_manager", user),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_server_manager.test", "user", user),
+					resource.TestCheckResourceAttr("pbs_server_manager.test", "id", user),
+					// The pre-existing root manager must be left alone
+					resource.TestCheckResourceAttrWith("data.pbs_server.test", "managers", testAccCheckListContains(user)),
+				),
+			},
+			{
+				ResourceName:      "pbs_server_manager.test",
+				ImportState:       true,
+				ImportStateId:     user,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccServerOperatorResource_basic(t *testing.T) {
+	first := testAccResourceName("op") + "@*"
+	second := testAccResourceName("op") + "@*"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerMembershipResourceConfig("pbs_server_operator", first),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_server_operator.test", "user", first),
+				),
+			},
+			// Replacing the entry should remove the old one and add the new one
+			{
+				Config: testAccServerMembershipResourceConfig("pbs_server_operator", second),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_server_operator.test", "user", second),
+					resource.TestCheckResourceAttrWith("data.pbs_server.test", "operators", testAccCheckListContains(second)),
+				),
+			},
+		},
+	})
+}
+
+// TestAccServerManagerResource_withServer checks that updating pbs_server with managers left unset doesn't remove
+// the entries owned by pbs_server_manager.
+func TestAccServerManagerResource_withServer(t *testing.T) {
+	user := testAccResourceName("mgr") + "@*"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:             testAccServerManagerWithServerConfig(user, "Manager test server"),
+				ResourceName:       "pbs_server.pbs",
+				ImportState:        true,
+				ImportStateId:      "pbs",
+				ImportStatePersist: true,
+			},
+			{
+				Config: testAccServerManagerWithServerConfig(user, "Manager test server"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("data.pbs_server.test", "managers", testAccCheckListContains(user)),
+				),
+			},
+			// Changing an unrelated attribute must leave the manager in place
+			{
+				Config: testAccServerManagerWithServerConfig(user, "Manager test server updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_server.pbs", "comment", "Manager test server updated"),
+					resource.TestCheckResourceAttrWith("data.pbs_server.test", "managers", testAccCheckListContains(user)),
+				),
+			},
+		},
+	})
+}
+
+func testAccServerManagerWithServerConfig(user, comment string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_server" "pbs" {
+  name                     = "pbs"
+  comment                  = %[2]q
+  scheduler_iteration      = 900
+  max_array_size           = 15000
+  node_fail_requeue        = 120
+  eligible_time_enable     = false
+  log_events               = 511
+  mailer                   = "/usr/sbin/sendmail"
+  mail_from                = "adm"
+  query_other_jobs         = true
+  resources_default = {
+    ncpus = "1"
+  }
+  resv_enable              = true
+  pbs_license_min          = 0
+  pbs_license_max          = 2147483647
+  pbs_license_linger_time  = 31536000
+  max_concurrent_provision = 5
+  power_provisioning       = false
+}
+
+resource "pbs_server_manager" "test" {
+  user = %[1]q
+}
+
+data "pbs_server" "test" {
+  name       = "pbs"
+  depends_on = [pbs_server.pbs, pbs_server_manager.test]
+}
+`, user, comment)
+}
+
+func testAccCheckListContains(entry string) resource.CheckResourceAttrWithFunc {
+	return func(value string) error {
+		for _, e := range strings.Split(value, ",") {
+			if strings.TrimSpace(e) == entry {
+				return nil
+			}
+		}
+		return fmt.Errorf("expected %q to contain %q", value, entry)
+	}
+}
+
+func testAccServerMembershipResourceConfig(resourceType, user string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource %[1]q "test" {
+  user = %[2]q
+}
+
+data "pbs_server" "test" {
+  name       = "pbs"
+  depends_on = [%[1]s.test]
+}
+`, resourceType, user)
+}
@@ -8,9 +8,12 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -40,6 +43,14 @@ func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Computed:            true,
 				MarkdownDescription: DescServerID,
 			},
+			"ignore_attributes": schema.SetAttribute{
+				MarkdownDescription: DescIgnoreAttributes,
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(newIgnoreAttributesValidator(serverModel{})),
+				},
+			},
 			"acl_host_enable": schema.BoolAttribute{
 				Optional:            true,
 				MarkdownDescription: DescServerAclHostEnable,
@@ -50,10 +61,14 @@ func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			},
 			"acl_hosts": schema.StringAttribute{
 				Optional:            true,
+				Computed:            true,
 				MarkdownDescription: DescServerAclHosts,
 				Validators: []validator.String{
 					validators.PbsString(),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"acl_hosts_normalized": schema.StringAttribute{
 				Computed:            true,
@@ -65,10 +80,14 @@ func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			},
 			"acl_resv_groups": schema.StringAttribute{
 				Optional:            true,
+				Computed:            true,
 				MarkdownDescription: DescServerAclResvGroups,
 				Validators: []validator.String{
 					validators.PbsString(),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"acl_resv_groups_normalized": schema.StringAttribute{
 				Computed:            true,
@@ -80,10 +99,14 @@ func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			},
 			"acl_resv_hosts": schema.StringAttribute{
 				Optional:            true,
+				Computed:            true,
 				MarkdownDescription: DescServerAclResvHosts,
 				Validators: []validator.String{
 					validators.PbsString(),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"acl_resv_hosts_normalized": schema.StringAttribute{
 				Computed:            true,
@@ -95,10 +118,14 @@ func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			},
 			"acl_resv_users": schema.StringAttribute{
 				Optional:            true,
+				Computed:            true,
 				MarkdownDescription: DescServerAclResvUsers,
 				Validators: []validator.String{
 					validators.PbsString(),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"acl_resv_users_normalized": schema.StringAttribute{
 				Computed:            true,
@@ -106,10 +133,14 @@ func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			},
 			"acl_roots": schema.StringAttribute{
 				Optional:            true,
+				Computed:            true,
 				MarkdownDescription: DescServerAclRoots,
 				Validators: []validator.String{
 					validators.PbsString(),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"acl_roots_normalized": schema.StringAttribute{
 				Computed:            true,
@@ -121,10 +152,14 @@ func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			},
 			"acl_users": schema.StringAttribute{
 				Optional:            true,
+				Computed:            true,
 				MarkdownDescription: DescServerAclUsers,
 				Validators: []validator.String{
 					validators.PbsString(),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"acl_users_normalized": schema.StringAttribute{
 				Computed:            true,
@@ -234,10 +269,14 @@ func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			},
 			"managers": schema.StringAttribute{
 				Optional:            true,
+				Computed:            true,
 				MarkdownDescription: DescServerManagers,
 				Validators: []validator.String{
 					validators.PbsString(),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"max_array_size": schema.Int32Attribute{
 				Optional:            true,
@@ -357,10 +396,14 @@ func (r *serverResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			},
 			"operators": schema.StringAttribute{
 				Optional:            true,
+				Computed:            true,
 				MarkdownDescription: DescServerOperators,
 				Validators: []validator.String{
 					validators.PbsString(),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"pbs_license_info": schema.StringAttribute{
 				Optional:            true,
@@ -545,7 +588,7 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var currentState serverModel
+	var currentState serverResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &currentState)...)
 
@@ -571,16 +614,21 @@ func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	updatedState := createServerModel(q)
+	updatedState := createServerResourceModel(q, currentState)
 
 	// Preserve user-provided ACL formats when semantically equivalent.
-	preserveUserServerAclFormatFromState(&currentState, &updatedState)
+	preserveUserServerAclFormatFromState(&currentState.serverModel, &updatedState.serverModel)
+	preserveEmptyList(currentState.Managers, &updatedState.Managers)
+	preserveEmptyList(currentState.Operators, &updatedState.Operators)
+
+	// Attributes managed elsewhere never show up as drift
+	preserveIgnoredAttributes(currentState.IgnoreAttributes, &currentState.serverModel, &updatedState.serverModel)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedState)...)
 }
 
 func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var planData, stateData serverModel
+	var planData, stateData serverResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
@@ -589,8 +637,38 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	server := planData.ToPbsServer(ctx)
-	_, err := r.client.UpdatePbsServer(server)
+	// Read the server name from the plan, falling back to the ID after an import
+	serverName := planData.Name.ValueString()
+	if serverName == "" && !planData.ID.IsNull() {
+		serverName = planData.ID.ValueString()
+	}
+
+	current, err := r.client.GetPbsServer(serverName)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read server, got error: %s", err))
+		return
+	}
+	currentModel := createServerModel(current)
+
+	// Attributes managed elsewhere keep whatever value they currently have on the server
+	desired := planData.serverModel
+	preserveIgnoredAttributes(planData.IgnoreAttributes, &currentModel, &desired)
+
+	// The lists shared with pbs_server_manager and pbs_server_operator are only written when the configuration
+	// changes them, so that entries those resources add in the same apply aren't overwritten
+	managersUnchanged := keepUnchangedList(planData.Managers, stateData.Managers, currentModel.Managers, &desired.Managers)
+	operatorsUnchanged := keepUnchangedList(planData.Operators, stateData.Operators, currentModel.Operators, &desired.Operators)
+
+	// Likewise the ACLs shared with pbs_server_acl_entry
+	keepUnchangedList(planData.AclHosts, stateData.AclHosts, currentModel.AclHosts, &desired.AclHosts)
+	keepUnchangedList(planData.AclResvGroups, stateData.AclResvGroups, currentModel.AclResvGroups, &desired.AclResvGroups)
+	keepUnchangedList(planData.AclResvHosts, stateData.AclResvHosts, currentModel.AclResvHosts, &desired.AclResvHosts)
+	keepUnchangedList(planData.AclResvUsers, stateData.AclResvUsers, currentModel.AclResvUsers, &desired.AclResvUsers)
+	keepUnchangedList(planData.AclRoots, stateData.AclRoots, currentModel.AclRoots, &desired.AclRoots)
+	keepUnchangedList(planData.AclUsers, stateData.AclUsers, currentModel.AclUsers, &desired.AclUsers)
+
+	server := desired.ToPbsServer(ctx)
+	_, err = r.client.UpdatePbsServer(server)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Update Resource",
@@ -603,11 +681,6 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Read the updated server to get the actual state including computed fields
-	serverName := planData.Name.ValueString()
-	if serverName == "" && !planData.ID.IsNull() {
-		serverName = planData.ID.ValueString()
-	}
-
 	updatedServer, err := r.client.GetPbsServer(serverName)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read updated server, got error: %s", err))
@@ -615,10 +688,15 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Create model from the actual server state
-	updatedData := createServerModel(updatedServer)
+	updatedData := createServerResourceModel(updatedServer, planData)
 
 	// Preserve user-provided ACL formats from plan where possible
-	preserveUserServerAclFormat(&planData, &updatedData)
+	preserveUserServerAclFormat(&planData.serverModel, &updatedData.serverModel)
+	preservePlannedList(planData.Managers, managersUnchanged, &updatedData.Managers)
+	preservePlannedList(planData.Operators, operatorsUnchanged, &updatedData.Operators)
+
+	// Attributes managed elsewhere are reported as configured
+	preserveIgnoredAttributes(planData.IgnoreAttributes, &planData.serverModel, &updatedData.serverModel)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedData)...)
@@ -1,19 +1,32 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"reflect"
 	"sort"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-// SetStringPointerIfNotNull sets a string pointer field if the types.String is not null.
+// SetStringPointerIfNotNull sets a string pointer field if the types.String is not null. Unknown values, such as
+// a computed attribute that isn't configured, are left unset as well.
 func SetStringPointerIfNotNull(field types.String, target **string) {
-	if !field.IsNull() {
+	if !field.IsNull() && !field.IsUnknown() {
 		*target = field.ValueStringPointer()
 	}
 }
 
+// SetListPointerIfNotEmpty sets a list attribute pointer like SetStringPointerIfNotNull, except that an empty
+// string leaves it nil. Lists that are kept from state when they aren't configured are unset by configuring "".
+func SetListPointerIfNotEmpty(field types.String, target **string) {
+	if field.ValueString() != "" {
+		SetStringPointerIfNotNull(field, target)
+	}
+}
+
 // SetBoolPointerIfNotNull sets a bool pointer field if the types.Bool is not null.
 func SetBoolPointerIfNotNull(field types.Bool, target **bool) {
 	if !field.IsNull() {
@@ -98,7 +111,7 @@ func preserveUserAclFormats(planFields, resultFields []AclFieldPair) {
 	}
 
 	for i := range planFields {
-		if !planFields[i].UserField.IsNull() {
+		if !planFields[i].UserField.IsNull() && !planFields[i].UserField.IsUnknown() {
 			resultFields[i].UserField = planFields[i].UserField
 		}
 	}
@@ -111,6 +124,7 @@ func preserveUserAclFormatsFromState(stateFields, updatedFields []AclFieldPair)
 	}
 
 	for i := range stateFields {
+		preserveEmptyList(stateFields[i].UserField, &updatedFields[i].UserField)
 		if !stateFields[i].UserField.IsNull() && !updatedFields[i].NormalizedField.IsNull() {
 			userFormat := stateFields[i].UserField.ValueString()
 			pbsFormat := updatedFields[i].NormalizedField.ValueString()
@@ -129,6 +143,37 @@ func addNormalizedAclField(source *string, target *types.String) {
 	}
 }
 
+// keepUnchangedList sets desired to the list's current value on the server when the plan doesn't change it, so that
+// entries added since the plan was made by resources managing single entries, such as pbs_server_manager, aren't
+// overwritten. It reports whether the list was left alone.
+func keepUnchangedList(plan, state, current types.String, desired *types.String) bool {
+	if !plan.IsUnknown() && !plan.Equal(state) {
+		return false
+	}
+
+	*desired = current
+	return true
+}
+
+// preservePlannedList saves a list as planned when the update left it alone, entries added to it in the meantime
+// are picked up on the next refresh, or when it was configured as "" which PBS reports as unset.
+func preservePlannedList(plan types.String, unchanged bool, result *types.String) {
+	if unchanged && !plan.IsUnknown() {
+		*result = plan
+		return
+	}
+
+	preserveEmptyList(plan, result)
+}
+
+// preserveEmptyList keeps a list configured as "", which PBS reports as unset, so that it doesn't show up as a
+// change on every plan.
+func preserveEmptyList(prior types.String, result *types.String) {
+	if !prior.IsNull() && !prior.IsUnknown() && prior.ValueString() == "" && result.IsNull() {
+		*result = prior
+	}
+}
+
 // convertStringMapToTypesStringMap converts a map[string]string to map[string]types.String.
 func convertStringMapToTypesStringMap(source map[string]string) map[string]types.String {
 	elements := make(map[string]types.String)
@@ -137,3 +182,140 @@ func convertStringMapToTypesStringMap(source map[string]string) map[string]types
 	}
 	return elements
 }
+
+// ignoreAttributesValidator checks that each ignore_attributes entry names an attribute of the model, and that
+// entries with a resource sub-key name a map attribute, so that a typo doesn't silently leave the attribute
+// managed by the resource.
+type ignoreAttributesValidator struct {
+	// attributes maps each tfsdk name to whether it is a map attribute
+	attributes map[string]bool
+}
+
+// newIgnoreAttributesValidator returns a validator for the attributes of model, which must be the same struct
+// that is passed to preserveIgnoredAttributes. The id and name of the object can't be ignored.
+func newIgnoreAttributesValidator(model any) ignoreAttributesValidator {
+	modelType := reflect.TypeOf(model)
+	attributes := make(map[string]bool, modelType.NumField())
+	for i := 0; i < modelType.NumField(); i++ {
+		name := modelType.Field(i).Tag.Get("tfsdk")
+		if name == "" || name == "id" || name == "name" {
+			continue
+		}
+		attributes[name] = modelType.Field(i).Type.Kind() == reflect.Map
+	}
+
+	return ignoreAttributesValidator{attributes: attributes}
+}
+
+func (v ignoreAttributesValidator) Description(_ context.Context) string {
+	return "value must be an attribute of this resource or a single resource of a map attribute"
+}
+
+func (v ignoreAttributesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ignoreAttributesValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attribute, key, isMapKey := strings.Cut(req.ConfigValue.ValueString(), ".")
+	isMap, ok := v.attributes[attribute]
+	switch {
+	case !ok:
+		resp.Diagnostics.AddAttributeError(req.Path, "Unknown Ignored Attribute",
+			fmt.Sprintf("%q is not an attribute of this resource", attribute))
+	case isMapKey && !isMap:
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Ignored Attribute",
+			fmt.Sprintf("%q is not a map attribute so %q can't be ignored on its own", attribute, req.ConfigValue.ValueString()))
+	case isMapKey && key == "":
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Ignored Attribute",
+			fmt.Sprintf("Expected a resource after %q", attribute+"."))
+	}
+}
+
+// preserveIgnoredAttributes copies each ignored attribute from prior into updated so that attributes managed by
+// another resource never show up as drift. Entries are either a top level attribute name such as "priority" or a
+// single key of a map attribute such as "resources_available.ngpus". Both arguments must be pointers to the same
+// model struct, fields are matched on their tfsdk tag.
+func preserveIgnoredAttributes(ignored []types.String, prior any, updated any) {
+	priorValue := reflect.ValueOf(prior).Elem()
+	updatedValue := reflect.ValueOf(updated).Elem()
+
+	for _, entry := range ignored {
+		attribute, key, isMapKey := strings.Cut(entry.ValueString(), ".")
+
+		index := -1
+		for i := 0; i < updatedValue.NumField(); i++ {
+			if updatedValue.Type().Field(i).Tag.Get("tfsdk") == attribute {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			continue
+		}
+
+		priorField := priorValue.Field(index)
+		updatedField := updatedValue.Field(index)
+		if !isMapKey {
+			updatedField.Set(priorField)
+			continue
+		}
+		if updatedField.Kind() != reflect.Map {
+			continue
+		}
+
+		// Copy the map before changing it so that maps shared with other models are left untouched
+		result := reflect.MakeMap(updatedField.Type())
+		iter := updatedField.MapRange()
+		for iter.Next() {
+			result.SetMapIndex(iter.Key(), iter.Value())
+		}
+		if priorEntry := priorField.MapIndex(reflect.ValueOf(key)); priorEntry.IsValid() {
+			result.SetMapIndex(reflect.ValueOf(key), priorEntry)
+		} else {
+			result.SetMapIndex(reflect.ValueOf(key), reflect.Value{})
+		}
+
+		// Keep a null map null rather than turning it into an empty one
+		if result.Len() == 0 && priorField.IsNil() {
+			result = reflect.Zero(updatedField.Type())
+		}
+		updatedField.Set(result)
+	}
+}
+
+// formatStateCount formats job counts per state for a diagnostic, e.g. "Queued: 3, Running: 1". States
+// without any jobs are left out.
+func formatStateCount(counts map[string]int) string {
+	states := make([]string, 0, len(counts))
+	for state, count := range counts {
+		if count > 0 {
+			states = append(states, state)
+		}
+	}
+	sort.Strings(states)
+
+	parts := make([]string, 0, len(states))
+	for _, state := range states {
+		parts = append(parts, fmt.Sprintf("%s: %d", state, counts[state]))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// convertStateCount converts job counts per state as parsed from a state_count attribute into a map for the
+// state, leaving it null if the attribute wasn't reported.
+func convertStateCount(counts map[string]int) map[string]types.Int32 {
+	if counts == nil {
+		return nil
+	}
+
+	result := make(map[string]types.Int32, len(counts))
+	for state, count := range counts {
+		result[state] = types.Int32Value(int32(count))
+	}
+	return result
+}
@@ -1,8 +1,11 @@
 package provider
 
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -101,6 +104,14 @@ func TestSetStringPointerIfNotNull(t *testing.T) {
 		t.Errorf("Expected target to remain nil for null field")
 	}
 
+	// Test unknown value - should not modify target
+	unknownField := types.StringUnknown()
+	SetStringPointerIfNotNull(unknownField, &target)
+
+	if target != nil {
+		t.Errorf("Expected target to remain nil for unknown field")
+	}
+
 	// Test non-null value
 	nonNullField := types.StringValue("test")
 	SetStringPointerIfNotNull(nonNullField, &target)
@@ -113,3 +124,176 @@ func TestSetStringPointerIfNotNull(t *testing.T) {
 		t.Errorf("Expected 'test', got %s", *target)
 	}
 }
+
+func TestSetListPointerIfNotEmpty(t *testing.T) {
+	tests := []struct {
+		field types.String
+		want  string
+	}{
+		{field: types.StringNull()},
+		{field: types.StringUnknown()},
+		{field: types.StringValue("")},
+		{field: types.StringValue("root@*"), want: "root@*"},
+	}
+
+	for _, tt := range tests {
+		var target *string
+		SetListPointerIfNotEmpty(tt.field, &target)
+		if tt.want == "" && target != nil {
+			t.Errorf("%s: got %q, wanted nil", tt.field, *target)
+		}
+		if tt.want != "" && (target == nil || *target != tt.want) {
+			t.Errorf("%s: got %v, wanted %q", tt.field, target, tt.want)
+		}
+	}
+}
+
+func TestKeepUnchangedList(t *testing.T) {
+	current := types.StringValue("root@*,admin@*")
+	tests := []struct {
+		name          string
+		plan          types.String
+		state         types.String
+		wantDesired   types.String
+		wantUnchanged bool
+	}{
+		{"unchanged", types.StringValue("root@*"), types.StringValue("root@*"), current, true},
+		{"unknown", types.StringUnknown(), types.StringNull(), current, true},
+		{"changed", types.StringValue("root@*,ops@*"), types.StringValue("root@*"), types.StringValue("root@*,ops@*"), false},
+		{"emptied", types.StringValue(""), types.StringValue("root@*"), types.StringValue(""), false},
+	}
+
+	for _, tt := range tests {
+		desired := tt.plan
+		unchanged := keepUnchangedList(tt.plan, tt.state, current, &desired)
+		if unchanged != tt.wantUnchanged {
+			t.Errorf("%s: got %t, wanted %t", tt.name, unchanged, tt.wantUnchanged)
+		}
+		if !desired.Equal(tt.wantDesired) {
+			t.Errorf("%s: got %q, wanted %q", tt.name, desired, tt.wantDesired)
+		}
+	}
+}
+
+func TestPreservePlannedList(t *testing.T) {
+	tests := []struct {
+		name      string
+		plan      types.String
+		unchanged bool
+		result    types.String
+		want      types.String
+	}{
+		{"unchanged", types.StringValue("root@*"), true, types.StringValue("root@*,admin@*"), types.StringValue("root@*")},
+		{"unknown", types.StringUnknown(), true, types.StringValue("root@*"), types.StringValue("root@*")},
+		{"changed", types.StringValue("admin@*,root@*"), false, types.StringValue("root@*,admin@*"), types.StringValue("root@*,admin@*")},
+		{"emptied", types.StringValue(""), false, types.StringNull(), types.StringValue("")},
+	}
+
+	for _, tt := range tests {
+		result := tt.result
+		preservePlannedList(tt.plan, tt.unchanged, &result)
+		if !result.Equal(tt.want) {
+			t.Errorf("%s: got %q, wanted %q", tt.name, result, tt.want)
+		}
+	}
+}
+
+func TestPreserveUserAclFormatsFromState(t *testing.T) {
+	state := []AclFieldPair{
+		{UserField: types.StringValue("staff,admin")},
+		{UserField: types.StringValue("")},
+	}
+	updated := []AclFieldPair{
+		{UserField: types.StringValue("admin,staff"), NormalizedField: types.StringValue("admin,staff")},
+		{UserField: types.StringNull(), NormalizedField: types.StringNull()},
+	}
+
+	preserveUserAclFormatsFromState(state, updated)
+	for i := range state {
+		if !updated[i].UserField.Equal(state[i].UserField) {
+			t.Errorf("got %q, wanted %q", updated[i].UserField, state[i].UserField)
+		}
+	}
+}
+
+func TestPreserveIgnoredAttributes(t *testing.T) {
+	ignored := []types.String{types.StringValue("priority"), types.StringValue("resources_available.ngpus")}
+
+	prior := pbsNodeModel{
+		Name:               types.StringValue("node01"),
+		Priority:           types.Int32Null(),
+		ResourcesAvailable: map[string]types.String{"ncpus": types.StringValue("8")},
+	}
+	updated := pbsNodeModel{
+		Name:     types.StringValue("node01"),
+		Priority: types.Int32Value(10),
+		ResourcesAvailable: map[string]types.String{
+			"ncpus": types.StringValue("8"),
+			"ngpus": types.StringValue("4"),
+		},
+	}
+	shared := updated.ResourcesAvailable
+
+	preserveIgnoredAttributes(ignored, &prior, &updated)
+
+	if !updated.Priority.IsNull() {
+		t.Errorf("Expected priority to be preserved as null, got %s", updated.Priority)
+	}
+	if _, ok := updated.ResourcesAvailable["ngpus"]; ok {
+		t.Errorf("Expected ngpus to be removed from resources_available")
+	}
+	if updated.ResourcesAvailable["ncpus"].ValueString() != "8" {
+		t.Errorf("Expected ncpus to be untouched, got %s", updated.ResourcesAvailable["ncpus"])
+	}
+	if _, ok := shared["ngpus"]; !ok {
+		t.Errorf("Expected the original map to be left untouched")
+	}
+
+	// A key that only exists in prior is copied across and a null map stays null
+	prior.ResourcesAvailable = map[string]types.String{"ngpus": types.StringValue("2")}
+	updated.ResourcesAvailable = nil
+	preserveIgnoredAttributes(ignored, &prior, &updated)
+	if updated.ResourcesAvailable["ngpus"].ValueString() != "2" {
+		t.Errorf("Expected ngpus to be copied from prior, got %s", updated.ResourcesAvailable["ngpus"])
+	}
+
+	prior.ResourcesAvailable = nil
+	updated.ResourcesAvailable = map[string]types.String{"ngpus": types.StringValue("4")}
+	preserveIgnoredAttributes(ignored, &prior, &updated)
+	if updated.ResourcesAvailable != nil {
+		t.Errorf("Expected resources_available to stay null, got %v", updated.ResourcesAvailable)
+	}
+}
+
+func TestIgnoreAttributesValidator(t *testing.T) {
+	v := newIgnoreAttributesValidator(pbsNodeModel{})
+
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{"priority", false},
+		{"resources_available", false},
+		{"resources_available.ngpus", false},
+		{"priorty", true},
+		{"name", true},
+		{"priority.high", true},
+		{"resources_available.", true},
+	}
+
+	for _, tt := range tests {
+		req := validator.StringRequest{Path: path.Root("ignore_attributes"), ConfigValue: types.StringValue(tt.value)}
+		resp := validator.StringResponse{}
+		v.ValidateString(context.Background(), req, &resp)
+		if resp.Diagnostics.HasError() != tt.wantErr {
+			t.Errorf("got error %t for %q, wanted %t", resp.Diagnostics.HasError(), tt.value, tt.wantErr)
+		}
+	}
+}
+
+func TestFormatStateCount(t *testing.T) {
+	got := formatStateCount(map[string]int{"Running": 1, "Queued": 3, "Held": 0})
+	if want := "Queued: 3, Running: 1"; got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
@@ -0,0 +1,371 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"terraform-provider-pbs/internal/pbsclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &vnodeDefinitionResource{}
+	_ resource.ResourceWithConfigure      = &vnodeDefinitionResource{}
+	_ resource.ResourceWithImportState    = &vnodeDefinitionResource{}
+	_ resource.ResourceWithValidateConfig = &vnodeDefinitionResource{}
+)
+
+var (
+	vnodeDefinitionNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_.\-]+$`)
+	vnodeNameRegex           = regexp.MustCompile(`^[^\s:=#'"]+$`)
+)
+
+func NewVnodeDefinitionResource() resource.Resource {
+	return &vnodeDefinitionResource{}
+}
+
+// vnodeDefinitionResource manages a version 2 configuration file inserted into a MoM with pbs_mom -s insert, which is
+// how a host is split into several vnodes such as one per socket or GPU.
+type vnodeDefinitionResource struct {
+	client *pbsclient.PbsClient
+}
+
+type vnodeDefinitionModel struct {
+	ID         types.String                `tfsdk:"id"`
+	Node       types.String                `tfsdk:"node"`
+	Host       types.String                `tfsdk:"host"`
+	Name       types.String                `tfsdk:"name"`
+	Connection *sshConnectionModel         `tfsdk:"connection"`
+	Vnodes     []vnodeDefinitionVnodeModel `tfsdk:"vnodes"`
+}
+
+type vnodeDefinitionVnodeModel struct {
+	Name               types.String            `tfsdk:"name"`
+	ResourcesAvailable map[string]types.String `tfsdk:"resources_available"`
+	Sharing            types.String            `tfsdk:"sharing"`
+	Priority           types.Int32             `tfsdk:"priority"`
+}
+
+func (m vnodeDefinitionModel) ToVnodeDefinitions() []pbsclient.VnodeDefinition {
+	vnodes := make([]pbsclient.VnodeDefinition, 0, len(m.Vnodes))
+	for _, v := range m.Vnodes {
+		vnode := pbsclient.VnodeDefinition{
+			Name:               v.Name.ValueString(),
+			Sharing:            v.Sharing.ValueStringPointer(),
+			Priority:           v.Priority.ValueInt32Pointer(),
+			ResourcesAvailable: map[string]string{},
+		}
+		for name, value := range v.ResourcesAvailable {
+			vnode.ResourcesAvailable[name] = value.ValueString()
+		}
+		vnodes = append(vnodes, vnode)
+	}
+
+	return vnodes
+}
+
+func createVnodeDefinitionModel(vnodes []pbsclient.VnodeDefinition, prior vnodeDefinitionModel) vnodeDefinitionModel {
+	model := vnodeDefinitionModel{
+		ID:         types.StringValue(prior.Node.ValueString() + "/" + prior.Name.ValueString()),
+		Node:       prior.Node,
+		Host:       prior.Host,
+		Name:       prior.Name,
+		Connection: prior.Connection,
+		Vnodes:     []vnodeDefinitionVnodeModel{},
+	}
+
+	for _, v := range vnodes {
+		vnode := vnodeDefinitionVnodeModel{
+			Name:     types.StringValue(v.Name),
+			Sharing:  types.StringPointerValue(v.Sharing),
+			Priority: types.Int32PointerValue(v.Priority),
+		}
+		if len(v.ResourcesAvailable) > 0 {
+			vnode.ResourcesAvailable = map[string]types.String{}
+			for name, value := range v.ResourcesAvailable {
+				vnode.ResourcesAvailable[name] = types.StringValue(value)
+			}
+		}
+		model.Vnodes = append(model.Vnodes, vnode)
+	}
+
+	return model
+}
+
+func (r *vnodeDefinitionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vnode_definition"
+}
+
+func (r *vnodeDefinitionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: DescVnodeDefinitionID,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"node": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: DescVnodeDefinitionNode,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: DescMomConfigHost,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: DescVnodeDefinitionName,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(vnodeDefinitionNameRegex, "must only contain letters, digits, _, . and -"),
+				},
+			},
+			"connection": sshConnectionSchema(),
+			"vnodes": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: DescVnodeDefinitionVnodes,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: DescVnodeName,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(vnodeNameRegex, "must be a vnode name such as node01[0]"),
+							},
+						},
+						"resources_available": schema.MapAttribute{
+							Optional:            true,
+							MarkdownDescription: DescVnodeResourcesAvailable,
+							ElementType:         types.StringType,
+							Validators: []validator.Map{
+								mapvalidator.SizeAtLeast(1),
+								mapvalidator.KeysAre(stringvalidator.RegexMatches(attributeResourceRegex, "must be a resource name")),
+								mapvalidator.ValueStringsAre(stringvalidator.RegexMatches(schedConfigValueRegex, "must be a single line without double quotes")),
+							},
+						},
+						"sharing": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: DescVnodeSharing,
+							Validators: []validator.String{
+								stringvalidator.OneOf(pbsclient.VnodeSharingValues...),
+							},
+						},
+						"priority": schema.Int32Attribute{
+							Optional:            true,
+							MarkdownDescription: DescVnodePriority,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *vnodeDefinitionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*pbsclient.PbsClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *pbsclient.PbsClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *vnodeDefinitionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var name types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if strings.HasPrefix(name.ValueString(), "PBS") {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Invalid Vnode Definition Name", "Names starting with PBS are reserved for PBS's own configuration files.")
+	}
+
+	// The vnodes can only be checked once they are fully known
+	var vnodes types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("vnodes"), &vnodes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if raw, err := vnodes.ToTerraformValue(ctx); err != nil || !raw.IsFullyKnown() {
+		return
+	}
+
+	var data vnodeDefinitionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateVnodeDefinition(data.Vnodes)...)
+}
+
+func (r *vnodeDefinitionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model vnodeDefinitionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mom, err := momHostClient(r.client, model.Node, &model.Host, model.Connection)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to connect to the MoM of %s, got error: %s", model.Node.ValueString(), err))
+		return
+	}
+
+	err = mom.CreateVnodeDefinitionFile(model.Name.ValueString(), model.ToVnodeDefinitions())
+	if err = warnIfMomNotRunning(&resp.Diagnostics, err); err != nil {
+		resp.Diagnostics.AddError("Error creating resource", fmt.Sprintf("Could not insert vnode definition %s on %s, unexpected error: %s", model.Name.ValueString(), model.Host.ValueString(), err))
+		return
+	}
+
+	model.ID = types.StringValue(model.Node.ValueString() + "/" + model.Name.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+func (r *vnodeDefinitionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vnodeDefinitionModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// For import, split the ID into the node and the name of the file
+	if state.Node.IsNull() && !state.ID.IsNull() {
+		node, name, ok := strings.Cut(state.ID.ValueString(), "/")
+		if !ok {
+			resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected an ID of the form <node>/<name>, got: %s", state.ID.ValueString()))
+			return
+		}
+		state.Node = types.StringValue(node)
+		state.Name = types.StringValue(name)
+	}
+
+	mom, err := momHostClient(r.client, state.Node, &state.Host, state.Connection)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to connect to the MoM of %s, got error: %s", state.Node.ValueString(), err))
+		return
+	}
+
+	vnodes, found, err := mom.GetVnodeDefinitionFile(state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read vnode definition %s on %s, got error: %s", state.Name.ValueString(), state.Host.ValueString(), err))
+		return
+	}
+
+	// If the file has been removed outside of terraform, remove it from the state
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, createVnodeDefinitionModel(vnodes, state))...)
+}
+
+func (r *vnodeDefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan vnodeDefinitionModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mom, err := momHostClient(r.client, plan.Node, &plan.Host, plan.Connection)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to connect to the MoM of %s, got error: %s", plan.Node.ValueString(), err))
+		return
+	}
+
+	err = mom.UpdateVnodeDefinitionFile(plan.Name.ValueString(), plan.ToVnodeDefinitions())
+	if err = warnIfMomNotRunning(&resp.Diagnostics, err); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the vnode definition. "+
+				"HTTP Error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.Node.ValueString() + "/" + plan.Name.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *vnodeDefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data vnodeDefinitionModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mom, err := momHostClient(r.client, data.Node, &data.Host, data.Connection)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to connect to the MoM of %s, got error: %s", data.Node.ValueString(), err))
+		return
+	}
+
+	err = mom.DeleteVnodeDefinitionFile(data.Name.ValueString())
+	if err = warnIfMomNotRunning(&resp.Diagnostics, err); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove vnode definition %s on %s, got error: %s", data.Name.ValueString(), data.Host.ValueString(), err))
+		return
+	}
+}
+
+func (r *vnodeDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Use the standard passthrough for ID, which will set both id and trigger a Read
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// validateVnodeDefinition checks that each vnode is only defined once.
+func validateVnodeDefinition(vnodes []vnodeDefinitionVnodeModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	seen := map[string]bool{}
+	for _, v := range vnodes {
+		if seen[v.Name.ValueString()] {
+			diags.AddAttributeError(path.Root("vnodes"), "Duplicate Vnode", fmt.Sprintf("The vnode %s is defined more than once.", v.Name.ValueString()))
+		}
+		seen[v.Name.ValueString()] = true
+	}
+
+	return diags
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"fmt"
+	"terraform-provider-pbs/internal/pbsclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestCreateVnodeDefinitionModel(t *testing.T) {
+	sharing := "force_excl"
+	prior := vnodeDefinitionModel{
+		Node: types.StringValue("gpu01"),
+		Host: types.StringValue("gpu01.example.com"),
+		Name: types.StringValue("gpus"),
+	}
+	model := createVnodeDefinitionModel([]pbsclient.VnodeDefinition{
+		{Name: "gpu01", Sharing: &sharing, ResourcesAvailable: map[string]string{}},
+		{Name: "gpu01[0]", ResourcesAvailable: map[string]string{"ngpus": "1"}},
+	}, prior)
+
+	if got := model.ID.ValueString(); got != "gpu01/gpus" {
+		t.Errorf("got %q, wanted %q", got, "gpu01/gpus")
+	}
+	if got := len(model.Vnodes); got != 2 {
+		t.Fatalf("got %d vnodes, wanted 2", got)
+	}
+	if model.Vnodes[0].ResourcesAvailable != nil {
+		t.Errorf("got %v, wanted null resources_available", model.Vnodes[0].ResourcesAvailable)
+	}
+	if !model.Vnodes[1].Priority.IsNull() {
+		t.Errorf("got %s, wanted null priority", model.Vnodes[1].Priority)
+	}
+	if got := model.Vnodes[1].ResourcesAvailable["ngpus"].ValueString(); got != "1" {
+		t.Errorf("got %q, wanted %q", got, "1")
+	}
+
+	roundTrip := pbsclient.FormatVnodeDefinitions(model.ToVnodeDefinitions())
+	want := "$configversion 2\ngpu01: sharing = force_excl\ngpu01[0]: resources_available.ngpus = 1\n"
+	if roundTrip != want {
+		t.Errorf("got %q, wanted %q", roundTrip, want)
+	}
+}
+
+func TestValidateVnodeDefinition(t *testing.T) {
+	vnodes := []vnodeDefinitionVnodeModel{
+		{Name: types.StringValue("pbs[0]")},
+		{Name: types.StringValue("pbs[1]")},
+	}
+	if diags := validateVnodeDefinition(vnodes); diags.HasError() {
+		t.Errorf("got %v, wanted no errors", diags)
+	}
+
+	vnodes = append(vnodes, vnodeDefinitionVnodeModel{Name: types.StringValue("pbs[0]")})
+	if diags := validateVnodeDefinition(vnodes); diags.ErrorsCount() != 1 {
+		t.Errorf("got %d errors, wanted 1", diags.ErrorsCount())
+	}
+}
+
+func TestAccVnodeDefinitionResource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVnodeDefinitionResourceConfig("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_vnode_definition.test", "id", "pbs/tfacc"),
+					resource.TestCheckResourceAttr("pbs_vnode_definition.test", "vnodes.#", "2"),
+					resource.TestCheckResourceAttr("pbs_vnode_definition.test", "vnodes.1.resources_available.ncpus", "1"),
+				),
+			},
+			{
+				Config: testAccVnodeDefinitionResourceConfig("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pbs_vnode_definition.test", "vnodes.1.resources_available.ncpus", "2"),
+				),
+			},
+			{
+				ResourceName:      "pbs_vnode_definition.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVnodeDefinitionResourceConfig(ncpus string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "pbs_vnode_definition" "test" {
+  node = "pbs"
+  name = "tfacc"
+
+  vnodes = [
+    {
+      name    = "pbs"
+      sharing = "default_shared"
+    },
+    {
+      name     = "pbs[0]"
+      priority = 10
+      resources_available = {
+        ncpus = %[1]q
+      }
+    },
+  ]
+}
+`, ncpus)
+}